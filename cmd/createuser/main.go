@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	config "github.com/jambo0624/blog/internal/shared/infrastructure/config"
+	userService "github.com/jambo0624/blog/internal/user/application/service"
+	userPersistence "github.com/jambo0624/blog/internal/user/infrastructure/repository"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+)
+
+// createuser adds a user from the command line, which is how the first
+// account is created before anyone can log in.
+func main() {
+	email := flag.String("email", "", "email address used to log in")
+	name := flag.String("name", "", "display name")
+	password := flag.String("password", "", "password, at least 8 characters")
+	role := flag.String("role", "admin", "role: admin, editor, author or reader")
+	flag.Parse()
+
+	// load config
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// connect to database
+	db, err := gorm.Open(postgres.Open(cfg.Database.URL), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	service := userService.NewUserService(userPersistence.NewGormUserRepository(db))
+	user, err := service.Create(context.Background(), &dto.CreateUserRequest{
+		Email:    *email,
+		Name:     *name,
+		Password: *password,
+		Role:     *role,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create user: %v", err)
+	}
+
+	log.Printf("Created %s %d <%s>", user.Role, user.ID, user.Email)
+}
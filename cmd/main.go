@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
@@ -41,9 +42,9 @@ func initSentry() {
 func main() {
 	initSentry()
 
-	// Set up graceful shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	// Shut down gracefully on interrupt or termination
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
 	// Load configuration
 	cfg, err := config.LoadConfig()
@@ -59,21 +60,27 @@ func main() {
 
 	// Initialize each layer
 	repos := bootstrap.SetupRepositories(db)
-	services := bootstrap.SetupServices(repos)
-	handlers := bootstrap.SetupHandlers(services)
-	router := bootstrap.SetupRouter(handlers)
+	services := bootstrap.SetupServices(cfg, repos)
+	handlers := bootstrap.SetupHandlers(cfg, services)
+	health := bootstrap.SetupHealth(db, repos, services, handlers)
+	router := bootstrap.SetupRouter(cfg, handlers, health, services.Auth)
 
-	// Start server in a new goroutine
-	go func() {
-		if err := router.Run(":" + cfg.Server.Port); err != nil {
-			handleFatalError(err, "Failed to start server")
-		}
-	}()
+	// Start background workers
+	workers := bootstrap.StartWorkers(context.Background(), bootstrap.SetupWorkers(cfg, db, repos, services))
 
-	// Wait for interrupt signal
-	<-quit
-	log.Println("Shutting down server...")
+	// Once requests have drained, stop the workers before closing the
+	// database they use
+	server := bootstrap.NewServer(cfg.Server, router).WithHealth(health)
+	server.OnShutdown("workers", workers.Stop)
+	server.OnShutdown("database", func(context.Context) error {
+		return persistence.CloseDB(db)
+	})
 
-	// Log only when exiting normally
+	// Serve until a signal arrives
+	if err := server.Run(ctx); err != nil {
+		handleFatalError(err, "Server shutdown failed")
+	}
+
+	sentry.Flush(sentryFlushTimeout)
 	log.Println("Server exited")
 }
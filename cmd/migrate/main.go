@@ -1,18 +1,60 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strconv"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
-	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
-	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
+	"github.com/jambo0624/blog/database/migrations"
 	config "github.com/jambo0624/blog/internal/shared/infrastructure/config"
-	tagEntity "github.com/jambo0624/blog/internal/tag/domain/entity"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/migration"
 )
 
+const usage = `usage: migrate [-dir path] <command>
+
+commands:
+  up             apply all pending migrations
+  down [N]       revert the last N applied migrations, 1 by default
+  status         list migrations and whether they have been applied
+  create <name>  add an empty up/down pair to the migrations directory
+`
+
+// migrate applies the numbered SQL migrations embedded in the binary and
+// records them in schema_migrations.
 func main() {
+	dir := flag.String("dir", "database/migrations", "migrations directory, used by create")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// create only touches the file system
+	if args[0] == "create" {
+		if len(args) != 2 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		up, down, err := migration.Create(*dir, args[1])
+		if err != nil {
+			log.Fatalf("Failed to create migration: %v", err)
+		}
+		log.Printf("Created %s and %s", up, down)
+		return
+	}
+
 	// load config
 	cfg, err := config.LoadConfig()
 	if err != nil {
@@ -25,15 +67,51 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
-	// execute migrations
-	err = db.AutoMigrate(
-		&articleEntity.Article{},
-		&categoryEntity.Category{},
-		&tagEntity.Tag{},
-	)
+	migrator, err := migration.NewMigrator(db, migrations.FS)
 	if err != nil {
-		log.Fatalf("Failed to migrate database: %v", err)
+		log.Fatalf("Failed to load migrations: %v", err)
 	}
+	migrator.WithSteps(migrations.Steps)
 
-	log.Println("Migration completed successfully")
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			log.Printf("Applied %03d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("Failed to migrate database: %v", err)
+		}
+		log.Printf("Database is up to date, %d migrations applied", len(applied))
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				log.Fatalf("Invalid number of migrations to revert: %s", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			log.Printf("Reverted %03d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("Failed to revert migrations: %v", err)
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("Failed to read migration status: %v", err)
+		}
+		for _, s := range statuses {
+			appliedAt := "-"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%03d  %-40s  %-8s  %s\n", s.Version, s.Name, s.State, appliedAt)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
 }
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_articles_scheduled_for;
+DROP INDEX IF EXISTS idx_articles_published_at;
+DROP INDEX IF EXISTS idx_articles_status;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS scheduled_for;
+ALTER TABLE articles DROP COLUMN IF EXISTS published_at;
+ALTER TABLE articles DROP COLUMN IF EXISTS status;
@@ -0,0 +1,8 @@
+-- add publishing lifecycle columns to articles
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'draft';
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS published_at TIMESTAMP;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS scheduled_for TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_articles_status ON articles (status);
+CREATE INDEX IF NOT EXISTS idx_articles_published_at ON articles (published_at);
+CREATE INDEX IF NOT EXISTS idx_articles_scheduled_for ON articles (scheduled_for);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS scheduled_publish_runs;
@@ -0,0 +1,12 @@
+-- create scheduled_publish_runs table
+CREATE TABLE IF NOT EXISTS scheduled_publish_runs (
+  id SERIAL PRIMARY KEY,
+  instance VARCHAR(255) NOT NULL,
+  started_at TIMESTAMP NOT NULL,
+  finished_at TIMESTAMP NOT NULL,
+  published INTEGER NOT NULL DEFAULT 0,
+  failed INTEGER NOT NULL DEFAULT 0,
+  error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_publish_runs_started_at ON scheduled_publish_runs (started_at);
@@ -0,0 +1,4 @@
+-- nest categories under an optional parent category
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS parent_id BIGINT REFERENCES categories(id);
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories(parent_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_articles_author_id;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS author_id;
+
+ALTER TABLE users DROP COLUMN IF EXISTS role;
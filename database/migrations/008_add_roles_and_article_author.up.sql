@@ -0,0 +1,6 @@
+-- store a role per user and record who wrote each article
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'reader';
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS author_id BIGINT REFERENCES users(id);
+
+CREATE INDEX IF NOT EXISTS idx_articles_author_id ON articles(author_id);
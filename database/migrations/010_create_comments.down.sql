@@ -0,0 +1,3 @@
+ALTER TABLE articles DROP COLUMN IF EXISTS comment_count;
+
+DROP TABLE IF EXISTS comments;
@@ -0,0 +1,22 @@
+-- create comments table, replies point at their parent comment
+CREATE TABLE IF NOT EXISTS comments (
+  id SERIAL PRIMARY KEY,
+  article_id INTEGER NOT NULL REFERENCES articles(id),
+  parent_id INTEGER REFERENCES comments(id),
+  user_id INTEGER NOT NULL REFERENCES users(id),
+  content TEXT NOT NULL,
+  status VARCHAR(20) DEFAULT 'pending',
+  moderated_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_article_id ON comments (article_id);
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+CREATE INDEX IF NOT EXISTS idx_comments_user_id ON comments (user_id);
+CREATE INDEX IF NOT EXISTS idx_comments_status ON comments (status);
+CREATE INDEX IF NOT EXISTS idx_comments_deleted_at ON comments (deleted_at);
+
+-- cached count of approved comments shown on article listings
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS comment_count BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+ALTER TABLE tags DROP COLUMN IF EXISTS version;
+
+ALTER TABLE categories DROP COLUMN IF EXISTS version;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS version;
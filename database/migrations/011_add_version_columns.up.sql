@@ -0,0 +1,6 @@
+-- optimistic locking: every update bumps version and is guarded by the one it was read at
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS article_slug_history;
+
+DROP INDEX IF EXISTS idx_articles_slug;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS slug;
@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/shared/domain/slug"
+)
+
+// backfillArticleSlugs derives a slug for every article that has none from
+// its title with slug.Make, as the application does for new articles, and
+// numbers it with slug.WithSuffix when an earlier article already took it.
+func backfillArticleSlugs(tx *gorm.DB) error {
+	var taken []string
+	if err := tx.Raw("SELECT slug FROM articles WHERE slug IS NOT NULL UNION SELECT slug FROM article_slug_history").
+		Scan(&taken).Error; err != nil {
+		return err
+	}
+	used := make(map[string]bool, len(taken))
+	for _, value := range taken {
+		used[value] = true
+	}
+
+	var articles []struct {
+		ID    uint
+		Title string
+	}
+	if err := tx.Raw("SELECT id, title FROM articles WHERE slug IS NULL ORDER BY id").Scan(&articles).Error; err != nil {
+		return err
+	}
+
+	for _, article := range articles {
+		base := slug.Make(article.Title)
+		value := base
+		for n := 2; used[value]; n++ {
+			value = slug.WithSuffix(base, n)
+		}
+		used[value] = true
+
+		if err := tx.Exec("UPDATE articles SET slug = ? WHERE id = ?", value, article.ID).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
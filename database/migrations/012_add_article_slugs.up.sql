@@ -0,0 +1,17 @@
+-- articles are addressable by slug; the migration's Go step derives one for
+-- existing articles from their title, 013 then makes the column required
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS slug VARCHAR(100);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_articles_slug ON articles (slug);
+
+-- slugs an article was renamed away from keep redirecting to it
+CREATE TABLE IF NOT EXISTS article_slug_history (
+  id SERIAL PRIMARY KEY,
+  article_id INTEGER NOT NULL REFERENCES articles(id),
+  slug VARCHAR(100) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_article_slug_history_slug ON article_slug_history (slug);
+
+CREATE INDEX IF NOT EXISTS idx_article_slug_history_article_id ON article_slug_history (article_id);
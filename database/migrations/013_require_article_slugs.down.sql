@@ -0,0 +1 @@
+ALTER TABLE articles ALTER COLUMN slug DROP NOT NULL;
@@ -0,0 +1,2 @@
+-- every article has a slug since the backfill in 012
+ALTER TABLE articles ALTER COLUMN slug SET NOT NULL;
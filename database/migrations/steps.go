@@ -0,0 +1,8 @@
+package migrations
+
+import "github.com/jambo0624/blog/internal/shared/infrastructure/migration"
+
+// Steps holds the Go steps of the migrations that need one, by version.
+var Steps = map[uint64]migration.Step{
+	12: backfillArticleSlugs,
+}
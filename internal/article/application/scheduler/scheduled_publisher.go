@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	articleService "github.com/jambo0624/blog/internal/article/application/service"
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	articleRepository "github.com/jambo0624/blog/internal/article/domain/repository"
+	"github.com/jambo0624/blog/internal/shared/domain/clock"
+)
+
+// lockKey identifies the advisory lock shared by all replicas.
+const lockKey int64 = 0x626c6f67_7075626c // "blogpubl"
+
+// batchSize caps the number of articles promoted in a single run.
+const batchSize = 100
+
+// Locker runs work while holding a cluster-wide lock.
+type Locker interface {
+	TryWithLock(ctx context.Context, key int64, fn func() error) (bool, error)
+}
+
+// ScheduledPublisher periodically publishes scheduled articles that are due.
+type ScheduledPublisher struct {
+	service  *articleService.ArticleService
+	runRepo  articleRepository.ScheduledPublishRunRepository
+	locker   Locker
+	clock    clock.Clock
+	interval time.Duration
+	instance string
+}
+
+func NewScheduledPublisher(
+	service *articleService.ArticleService,
+	runRepo articleRepository.ScheduledPublishRunRepository,
+	locker Locker,
+	interval time.Duration,
+) *ScheduledPublisher {
+	instance, err := os.Hostname()
+	if err != nil {
+		instance = "unknown"
+	}
+
+	return &ScheduledPublisher{
+		service:  service,
+		runRepo:  runRepo,
+		locker:   locker,
+		clock:    clock.New(),
+		interval: interval,
+		instance: instance,
+	}
+}
+
+// WithClock replaces the clock used to timestamp runs.
+func (p *ScheduledPublisher) WithClock(c clock.Clock) *ScheduledPublisher {
+	p.clock = c
+	return p
+}
+
+// Start runs the publisher on every tick until ctx is cancelled.
+func (p *ScheduledPublisher) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := p.RunOnce(ctx); err != nil {
+				sentry.CaptureException(err)
+				log.Printf("Scheduled publisher run failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce publishes due articles if this instance holds the lock. It reports
+// whether the run happened, which is false when another replica holds the lock.
+func (p *ScheduledPublisher) RunOnce(ctx context.Context) (bool, error) {
+	return p.locker.TryWithLock(ctx, lockKey, func() error {
+		run := articleEntity.NewScheduledPublishRun(p.instance, p.clock.Now())
+		published, failed, publishErr := p.publishDue(ctx)
+		run.Finish(p.clock.Now(), published, failed, publishErr)
+
+		if err := p.runRepo.Save(ctx, run); err != nil {
+			return fmt.Errorf("failed to record scheduled publish run: %w", err)
+		}
+		return publishErr
+	})
+}
+
+// publishDue promotes every due article, continuing past individual failures.
+func (p *ScheduledPublisher) publishDue(ctx context.Context) (int, int, error) {
+	articles, err := p.service.FindDueScheduled(ctx, batchSize)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to find due articles: %w", err)
+	}
+
+	published, failed := 0, 0
+	for _, article := range articles {
+		if _, err := p.service.Publish(ctx, article.ID); err != nil {
+			sentry.CaptureException(err)
+			failed++
+			continue
+		}
+		published++
+	}
+
+	return published, failed, nil
+}
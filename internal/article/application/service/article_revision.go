@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/jambo0624/blog/internal/article/domain/diff"
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+)
+
+// FindRevisions lists the revisions of an article, newest first.
+func (s *ArticleService) FindRevisions(ctx context.Context, articleID uint) ([]*articleEntity.ArticleRevision, error) {
+	revisions, err := s.revisionRepo.FindByArticleID(ctx, articleID)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to find revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// FindRevision returns a single revision of an article.
+func (s *ArticleService) FindRevision(ctx context.Context, articleID, number uint) (*articleEntity.ArticleRevision, error) {
+	revision, err := s.revisionRepo.FindByNumber(ctx, articleID, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find revision: %w", err)
+	}
+	return revision, nil
+}
+
+// DiffRevisions compares two revisions of an article line by line, refusing
+// revisions too large to compare.
+func (s *ArticleService) DiffRevisions(ctx context.Context, articleID, from, to uint) (*diff.RevisionDiff, error) {
+	fromRevision, err := s.FindRevision(ctx, articleID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := s.FindRevision(ctx, articleID, to)
+	if err != nil {
+		return nil, err
+	}
+	return diff.CompareRevisions(fromRevision, toRevision)
+}
+
+// RestoreRevision makes an old revision the current content, which itself
+// is recorded as a new revision.
+func (s *ArticleService) RestoreRevision(ctx context.Context, articleID, number uint) (*articleEntity.Article, error) {
+	var article *articleEntity.Article
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		article, err = s.FindByID(ctx, articleID)
+		if err != nil {
+			sentry.CaptureException(err)
+			return fmt.Errorf("failed to find article by id: %w", err)
+		}
+
+		revision, err := s.FindRevision(ctx, articleID, number)
+		if err != nil {
+			return err
+		}
+
+		if err := article.RestoreRevision(revision, s.clock.Now()); err != nil {
+			return fmt.Errorf("failed to restore revision: %w", err)
+		}
+
+		if err := s.Repo.Update(ctx, article); err != nil {
+			sentry.CaptureException(err)
+			return fmt.Errorf("failed to update article: %w", err)
+		}
+
+		return s.recordRevision(ctx, article)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return article, nil
+}
+
+// recordRevision snapshots the article as its next revision.
+func (s *ArticleService) recordRevision(ctx context.Context, article *articleEntity.Article) error {
+	latest, err := s.revisionRepo.LatestNumber(ctx, article.ID)
+	if err != nil {
+		sentry.CaptureException(err)
+		return fmt.Errorf("failed to find latest revision: %w", err)
+	}
+
+	revision := articleEntity.NewArticleRevision(article, latest+1, s.clock.Now())
+	if err := s.revisionRepo.Save(ctx, revision); err != nil {
+		sentry.CaptureException(err)
+		return fmt.Errorf("failed to save revision: %w", err)
+	}
+	return nil
+}
@@ -1,104 +1,399 @@
 package service
 
 import (
+	"context"
+	stdErrors "errors"
 	"fmt"
 
 	"github.com/getsentry/sentry-go"
 
 	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
 	"github.com/jambo0624/blog/internal/article/domain/query"
+	articleRepository "github.com/jambo0624/blog/internal/article/domain/repository"
 	"github.com/jambo0624/blog/internal/article/interfaces/http/dto"
+	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
 	categoryRepository "github.com/jambo0624/blog/internal/category/domain/repository"
 	"github.com/jambo0624/blog/internal/shared/application/service"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/clock"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
 	"github.com/jambo0624/blog/internal/shared/domain/repository"
+	"github.com/jambo0624/blog/internal/shared/domain/slug"
 	tagEntity "github.com/jambo0624/blog/internal/tag/domain/entity"
 	tagRepository "github.com/jambo0624/blog/internal/tag/domain/repository"
 )
 
+// maxSlugAttempts bounds the numbered variants tried for a derived slug.
+const maxSlugAttempts = 100
+
 type ArticleService struct {
 	*service.BaseService[articleEntity.Article, *query.ArticleQuery]
+	articleRepo  articleRepository.ArticleRepository
 	categoryRepo categoryRepository.CategoryRepository
 	tagRepo      tagRepository.TagRepository
+	revisionRepo articleRepository.ArticleRevisionRepository
+	contents     *contentRenderer
+	clock        clock.Clock
+	policy       *auth.Policy
+	uow          repository.UnitOfWork
 }
 
 func NewArticleService(
-	repo repository.BaseRepository[articleEntity.Article, *query.ArticleQuery],
+	repo articleRepository.ArticleRepository,
 	cr categoryRepository.CategoryRepository,
 	tr tagRepository.TagRepository,
+	rr articleRepository.ArticleRevisionRepository,
 ) *ArticleService {
-	baseService := service.NewBaseService(repo)
+	baseService := service.NewBaseService[articleEntity.Article, *query.ArticleQuery](repo)
 
 	return &ArticleService{
 		BaseService:  baseService,
+		articleRepo:  repo,
+		contents:     newContentRenderer(),
 		categoryRepo: cr,
 		tagRepo:      tr,
+		revisionRepo: rr,
+		clock:        clock.New(),
+		policy:       auth.NewPolicy(),
+		uow:          repository.NewDirectUnitOfWork(),
 	}
 }
 
-func (s *ArticleService) Create(req *dto.CreateArticleRequest) (*articleEntity.Article, error) {
-	category, err := s.categoryRepo.FindByID(req.CategoryID)
+// WithClock replaces the clock used for timestamps and scheduling.
+func (s *ArticleService) WithClock(c clock.Clock) *ArticleService {
+	s.clock = c
+	return s
+}
+
+// WithUnitOfWork replaces the unit of work that makes multi-step writes
+// atomic.
+func (s *ArticleService) WithUnitOfWork(uow repository.UnitOfWork) *ArticleService {
+	s.uow = uow
+	return s
+}
+
+// Authorize checks whether principal may perform action on the article,
+// taking its author into account. id is 0 for actions on no existing article.
+// Reading an article that is not published is checked by AuthorizeRead.
+// Trashed articles cannot be loaded, so restore and purge are checked by role.
+func (s *ArticleService) Authorize(ctx context.Context, principal *auth.Principal, action auth.Action, id uint) error {
+	if id == 0 || action == auth.ActionCreate ||
+		action == auth.ActionRestore || action == auth.ActionPurge {
+		return s.policy.Authorize(principal, action, auth.ResourceArticle)
+	}
+
+	article, err := s.FindByID(ctx, id)
 	if err != nil {
-		sentry.CaptureException(err)
+		return err
+	}
+	if action == auth.ActionRead {
+		return s.AuthorizeRead(principal, article)
+	}
+	return s.policy.AuthorizeOwned(principal, action, auth.ResourceArticle, article.AuthorID)
+}
+
+// AuthorizeRead lets anyone read a published article. Any other article is
+// reported as not found to all but those allowed to read it unpublished, so
+// its existence does not leak.
+func (s *ArticleService) AuthorizeRead(principal *auth.Principal, article *articleEntity.Article) error {
+	if article.IsPublished() {
+		return s.policy.Authorize(principal, auth.ActionRead, auth.ResourceArticle)
+	}
+	if err := s.policy.AuthorizeOwned(principal, auth.ActionReadUnpublished, auth.ResourceArticle, article.AuthorID); err != nil {
+		return errors.ErrArticleNotFound
+	}
+	return nil
+}
 
-		return nil, fmt.Errorf("category not found: %w", err)
+// AuthorizeQuery checks that principal may list what q asks for. Published
+// articles are public; any other status takes ActionReadUnpublished, which
+// authors only hold when q is limited to their own articles.
+func (s *ArticleService) AuthorizeQuery(_ context.Context, principal *auth.Principal, q *query.ArticleQuery) error {
+	if q.IsPublishedOnly() {
+		return nil
 	}
+	return s.policy.AuthorizeOwned(principal, auth.ActionReadUnpublished, auth.ResourceArticle, q.AuthorID)
+}
+
+// Present renders the content of articles about to be returned with their
+// body. Renderings are cached per article version, so only the read paths
+// that need the HTML pay for it.
+func (s *ArticleService) Present(articles ...*articleEntity.Article) {
+	for _, article := range articles {
+		s.contents.Render(article)
+	}
+}
 
-	tags := make([]tagEntity.Tag, 0, len(req.TagIDs))
-	for _, tagID := range req.TagIDs {
-		tag, err := s.tagRepo.FindByID(tagID)
+// Create saves a new article and its first revision in one transaction.
+func (s *ArticleService) Create(ctx context.Context, req *dto.CreateArticleRequest) (*articleEntity.Article, error) {
+	var article *articleEntity.Article
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		category, tags, err := s.loadTaxonomy(ctx, req.CategoryID, req.TagIDs)
+		if err != nil {
+			return err
+		}
+
+		article, err = articleEntity.NewArticle(category, req.Title, req.Content, tags, s.clock.Now())
 		if err != nil {
 			sentry.CaptureException(err)
 
-			return nil, fmt.Errorf("tag not found: %w", err)
+			return fmt.Errorf("failed to create article: %w", err)
 		}
-		tags = append(tags, *tag)
-	}
 
-	article, err := articleEntity.NewArticle(category, req.Title, req.Content, tags)
+		if req.AuthorID != 0 {
+			article.AssignAuthor(req.AuthorID)
+		}
+
+		if err := s.assignSlug(ctx, article, req.Slug); err != nil {
+			return err
+		}
+
+		if err := s.Repo.Save(ctx, article); err != nil {
+			sentry.CaptureException(err)
+
+			return fmt.Errorf("failed to save article: %w", err)
+		}
+
+		return s.recordRevision(ctx, article)
+	})
 	if err != nil {
-		sentry.CaptureException(err)
+		return nil, err
+	}
+	return article, nil
+}
+
+// Update changes the article and records the result as a new revision in
+// one transaction.
+func (s *ArticleService) Update(ctx context.Context, id uint, req *dto.UpdateArticleRequest) (*articleEntity.Article, error) {
+	var article *articleEntity.Article
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		article, err = s.FindByID(ctx, id)
+		if err != nil {
+			sentry.CaptureException(err)
+
+			return fmt.Errorf("failed to find article by id: %w", err)
+		}
+
+		if err := req.CheckVersion(article.Version); err != nil {
+			return err
+		}
+
+		category, tags, err := s.loadTaxonomy(ctx, req.CategoryID, req.TagIDs)
+		if err != nil {
+			return err
+		}
+
+		article.Update(req, category, tags, s.clock.Now())
+
+		previousSlug := article.Slug
+		if req.Slug != "" && req.Slug != article.Slug {
+			if err := s.assignSlug(ctx, article, req.Slug); err != nil {
+				return err
+			}
+		}
+
+		if err := s.Repo.Update(ctx, article); err != nil {
+			sentry.CaptureException(err)
+
+			return fmt.Errorf("failed to update article: %w", err)
+		}
 
-		return nil, fmt.Errorf("failed to create article: %w", err)
+		if article.Slug != previousSlug {
+			if err := s.articleRepo.RecordSlugChange(ctx, article.ID, previousSlug, article.Slug); err != nil {
+				sentry.CaptureException(err)
+
+				return fmt.Errorf("failed to record slug change: %w", err)
+			}
+		}
+
+		return s.recordRevision(ctx, article)
+	})
+	if err != nil {
+		return nil, err
 	}
+	return article, nil
+}
 
-	if err := s.Repo.Save(article); err != nil {
-		sentry.CaptureException(err)
+// assignSlug gives the article the requested slug, or when none is requested
+// the one derived from its title, numbered until it is free.
+func (s *ArticleService) assignSlug(ctx context.Context, article *articleEntity.Article, requested string) error {
+	if requested != "" {
+		if _, err := article.ChangeSlug(requested); err != nil {
+			return err
+		}
+		taken, err := s.articleRepo.SlugTaken(ctx, requested, article.ID)
+		if err != nil {
+			sentry.CaptureException(err)
 
-		return nil, fmt.Errorf("failed to save article: %w", err)
+			return fmt.Errorf("failed to check slug: %w", err)
+		}
+		if taken {
+			return fmt.Errorf("%w: %s", errors.ErrSlugTaken, requested)
+		}
+		return nil
 	}
 
-	return article, nil
+	base := article.Slug
+	for n := 2; n < maxSlugAttempts; n++ {
+		taken, err := s.articleRepo.SlugTaken(ctx, article.Slug, article.ID)
+		if err != nil {
+			sentry.CaptureException(err)
+
+			return fmt.Errorf("failed to check slug: %w", err)
+		}
+		if !taken {
+			return nil
+		}
+		article.Slug = slug.WithSuffix(base, n)
+	}
+	return fmt.Errorf("%w: %s", errors.ErrSlugTaken, base)
 }
 
-func (s *ArticleService) Update(id uint, req *dto.UpdateArticleRequest) (*articleEntity.Article, error) {
-	article, err := s.FindByID(id)
+// FindBySlug finds the article addressed by value, now or before a rename.
+// The caller can tell the two apart by comparing value with the article's slug.
+func (s *ArticleService) FindBySlug(ctx context.Context, value string, preloadAssociations ...string) (*articleEntity.Article, error) {
+	article, err := s.articleRepo.FindBySlug(ctx, value, preloadAssociations...)
+	if err == nil {
+		return article, nil
+	}
+	if !stdErrors.Is(err, errors.ErrNotFound) {
+		sentry.CaptureException(err)
+
+		return nil, fmt.Errorf("failed to find article by slug: %w", err)
+	}
+
+	article, err = s.articleRepo.FindByPreviousSlug(ctx, value)
 	if err != nil {
 		sentry.CaptureException(err)
 
-		return nil, fmt.Errorf("failed to find article by id: %w", err)
+		return nil, fmt.Errorf("failed to find article by slug: %w", err)
 	}
+	return article, nil
+}
 
-	category, err := s.categoryRepo.FindByID(req.CategoryID)
+// loadTaxonomy loads the category and tags an article is filed under.
+func (s *ArticleService) loadTaxonomy(
+	ctx context.Context,
+	categoryID uint,
+	tagIDs []uint,
+) (*categoryEntity.Category, []tagEntity.Tag, error) {
+	category, err := s.categoryRepo.FindByID(ctx, categoryID)
+	if stdErrors.Is(err, errors.ErrNotFound) {
+		return nil, nil, errors.ErrUnknownCategory
+	}
 	if err != nil {
 		sentry.CaptureException(err)
 
-		return nil, fmt.Errorf("category not found: %w", err)
+		return nil, nil, fmt.Errorf("failed to find category: %w", err)
 	}
 
-	tags := make([]tagEntity.Tag, 0, len(req.TagIDs))
-	for _, tagID := range req.TagIDs {
-		tag, err := s.tagRepo.FindByID(tagID)
+	tags := make([]tagEntity.Tag, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		tag, err := s.tagRepo.FindByID(ctx, tagID)
+		if stdErrors.Is(err, errors.ErrNotFound) {
+			return nil, nil, errors.ErrUnknownTag
+		}
 		if err != nil {
 			sentry.CaptureException(err)
 
-			return nil, fmt.Errorf("tag not found: %w", err)
+			return nil, nil, fmt.Errorf("failed to find tag: %w", err)
 		}
 		tags = append(tags, *tag)
 	}
 
-	article.Update(req, category, tags)
+	return category, tags, nil
+}
+
+// Publish makes the article public immediately.
+func (s *ArticleService) Publish(ctx context.Context, id uint) (*articleEntity.Article, error) {
+	return s.changeStatus(ctx, id, func(article *articleEntity.Article) error {
+		return article.Publish(s.clock.Now())
+	})
+}
+
+// Unpublish takes a published or scheduled article back to draft.
+func (s *ArticleService) Unpublish(ctx context.Context, id uint) (*articleEntity.Article, error) {
+	return s.changeStatus(ctx, id, func(article *articleEntity.Article) error {
+		return article.Unpublish(s.clock.Now())
+	})
+}
+
+// Schedule sets the article to be published at the requested time.
+func (s *ArticleService) Schedule(ctx context.Context, id uint, req *dto.ScheduleArticleRequest) (*articleEntity.Article, error) {
+	return s.changeStatus(ctx, id, func(article *articleEntity.Article) error {
+		return article.Schedule(req.ScheduledFor, s.clock.Now())
+	})
+}
+
+// Search runs a full-text search, returning matches ordered by relevance.
+func (s *ArticleService) Search(ctx context.Context, q *query.ArticleQuery) ([]*articleEntity.ArticleSearchResult, int64, error) {
+	if err := q.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("failed to validate query: %w", err)
+	}
+
+	results, total, err := s.articleRepo.Search(ctx, q)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, 0, fmt.Errorf("failed to search articles: %w", err)
+	}
+	return results, total, nil
+}
+
+// FindPublishedLinks pages through the published articles by id, loading
+// only their id, slug and modification time.
+func (s *ArticleService) FindPublishedLinks(ctx context.Context, limit, offset int) ([]*articleEntity.ArticleLink, error) {
+	links, err := s.articleRepo.FindPublishedLinks(ctx, limit, offset)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to find published articles: %w", err)
+	}
+	return links, nil
+}
+
+// CountPublished counts the published articles.
+func (s *ArticleService) CountPublished(ctx context.Context) (int64, error) {
+	total, err := s.articleRepo.CountPublished(ctx)
+	if err != nil {
+		sentry.CaptureException(err)
+		return 0, fmt.Errorf("failed to count published articles: %w", err)
+	}
+	return total, nil
+}
+
+// FindDueScheduled returns scheduled articles whose publish time has passed.
+func (s *ArticleService) FindDueScheduled(ctx context.Context, limit int) ([]*articleEntity.Article, error) {
+	q := query.NewArticleQuery().
+		WithStatuses(string(articleEntity.StatusScheduled)).
+		WithScheduledBefore(s.clock.Now())
+	q.WithPagination(limit, 0)
+	q.WithOrderBy("scheduled_for")
+
+	articles, _, err := s.FindAll(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
+
+// changeStatus loads the article, applies the transition and persists it.
+func (s *ArticleService) changeStatus(
+	ctx context.Context,
+	id uint,
+	transition func(article *articleEntity.Article) error,
+) (*articleEntity.Article, error) {
+	article, err := s.FindByID(ctx, id)
+	if err != nil {
+		sentry.CaptureException(err)
+
+		return nil, fmt.Errorf("failed to find article by id: %w", err)
+	}
+
+	if err := transition(article); err != nil {
+		return nil, fmt.Errorf("failed to change article status: %w", err)
+	}
 
-	if err := s.Repo.Update(article); err != nil {
+	if err := s.Repo.Update(ctx, article); err != nil {
 		sentry.CaptureException(err)
 
 		return nil, fmt.Errorf("failed to update article: %w", err)
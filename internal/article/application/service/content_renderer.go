@@ -0,0 +1,67 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/getsentry/sentry-go"
+
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	"github.com/jambo0624/blog/internal/article/domain/markdown"
+)
+
+// renderCacheSize bounds the number of rendered articles kept in memory.
+const renderCacheSize = 1000
+
+type renderedContent struct {
+	version  uint
+	document *markdown.Document
+}
+
+// contentRenderer renders article content, caching the result per article.
+// Entries are keyed by version as well, so a cached rendering never outlives
+// an update made elsewhere.
+type contentRenderer struct {
+	markdown *markdown.Renderer
+	mu       sync.Mutex
+	cache    map[uint]renderedContent
+}
+
+func newContentRenderer() *contentRenderer {
+	return &contentRenderer{
+		markdown: markdown.NewRenderer(),
+		cache:    make(map[uint]renderedContent),
+	}
+}
+
+// Render sets article.Rendered. Articles that fail to render are returned
+// with their source only.
+func (r *contentRenderer) Render(article *articleEntity.Article) {
+	r.mu.Lock()
+	cached, ok := r.cache[article.ID]
+	r.mu.Unlock()
+	if ok && cached.version == article.Version {
+		article.Rendered = cached.document
+		return
+	}
+
+	document, err := r.markdown.Render(article.Content)
+	if err != nil {
+		sentry.CaptureException(err)
+		return
+	}
+	article.Rendered = document
+
+	if article.ID == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.cache) >= renderCacheSize {
+		// drop an arbitrary entry; map iteration order is random
+		for id := range r.cache {
+			delete(r.cache, id)
+			break
+		}
+	}
+	r.cache[article.ID] = renderedContent{version: article.Version, document: document}
+}
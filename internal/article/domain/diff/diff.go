@@ -0,0 +1,82 @@
+package diff
+
+import "strings"
+
+// Op is the kind of change applied to a line.
+type Op string
+
+const (
+	OpEqual  Op = "equal"
+	OpInsert Op = "insert"
+	OpDelete Op = "delete"
+)
+
+// Line is a single line of a diff.
+type Line struct {
+	Op   Op     `json:"op"`
+	Text string `json:"text"`
+}
+
+// Lines computes a line based diff turning from into to, using the longest
+// common subsequence of lines.
+func Lines(from, to string) []Line {
+	a := splitLines(from)
+	b := splitLines(to)
+
+	// lcs[i][j] holds the LCS length of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]Line, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, Line{Op: OpEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, Line{Op: OpDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, Line{Op: OpInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, Line{Op: OpDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, Line{Op: OpInsert, Text: b[j]})
+	}
+
+	return lines
+}
+
+// HasChanges reports whether the diff contains any insertion or deletion.
+func HasChanges(lines []Line) bool {
+	for _, l := range lines {
+		if l.Op != OpEqual {
+			return true
+		}
+	}
+	return false
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
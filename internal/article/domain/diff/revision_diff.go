@@ -0,0 +1,40 @@
+package diff
+
+import (
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+// MaxCells bounds the product of the line counts of the two sides of a
+// revision diff, as Lines takes time and memory proportional to it.
+const MaxCells = 1 << 20
+
+// RevisionDiff describes the changes between two revisions of an article.
+type RevisionDiff struct {
+	ArticleID uint   `json:"articleId"`
+	From      uint   `json:"from"`
+	To        uint   `json:"to"`
+	Title     []Line `json:"title"`
+	Content   []Line `json:"content"`
+	Changed   bool   `json:"changed"`
+}
+
+// CompareRevisions diffs the title and content of two revisions. Revisions
+// whose line counts multiply to more than MaxCells are refused.
+func CompareRevisions(from, to *articleEntity.ArticleRevision) (*RevisionDiff, error) {
+	if len(splitLines(from.Content))*len(splitLines(to.Content)) > MaxCells {
+		return nil, errors.ErrDiffTooLarge
+	}
+
+	title := Lines(from.Title, to.Title)
+	content := Lines(from.Content, to.Content)
+
+	return &RevisionDiff{
+		ArticleID: to.ArticleID,
+		From:      from.Number,
+		To:        to.Number,
+		Title:     title,
+		Content:   content,
+		Changed:   HasChanges(title) || HasChanges(content),
+	}, nil
+}
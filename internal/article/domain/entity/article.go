@@ -3,25 +3,44 @@ package entity
 import (
 	"time"
 
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/article/domain/markdown"
 	"github.com/jambo0624/blog/internal/article/interfaces/http/dto"
 	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
 	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/domain/slug"
 	tagEntity "github.com/jambo0624/blog/internal/tag/domain/entity"
+	userEntity "github.com/jambo0624/blog/internal/user/domain/entity"
 )
 
 type Article struct {
-	ID         uint                    `binding:"required"                        gorm:"primaryKey"         json:"id"`
-	CategoryID uint                    `binding:"required"                        gorm:"not null"           json:"categoryId"`
-	Category   categoryEntity.Category `gorm:"foreignKey:CategoryID"              json:"category"`
-	Title      string                  `binding:"required"                        gorm:"size:255;not null"  json:"title"`
-	Content    string                  `binding:"required"                        gorm:"type:text;not null" json:"content"`
-	Tags       []tagEntity.Tag         `gorm:"many2many:article_tags"             json:"tags"`
-	CreatedAt  time.Time               `gorm:"not null;default:CURRENT_TIMESTAMP" json:"createdAt"`
-	UpdatedAt  time.Time               `gorm:"not null;default:CURRENT_TIMESTAMP" json:"updatedAt"`
-	DeletedAt  *time.Time              `gorm:"index"                              json:"deletedAt"`
-}
-
-func NewArticle(category *categoryEntity.Category, title, content string, tags []tagEntity.Tag) (*Article, error) {
+	ID           uint                    `binding:"required"                        gorm:"primaryKey"         json:"id"`
+	CategoryID   uint                    `binding:"required"                        gorm:"not null"           json:"categoryId"`
+	Category     categoryEntity.Category `gorm:"foreignKey:CategoryID"              json:"category"`
+	Title        string                  `binding:"required"                        gorm:"size:255;not null"  json:"title"`
+	Slug         string                  `gorm:"size:100;not null;uniqueIndex"      json:"slug"`
+	Content      string                  `binding:"required"                        gorm:"type:text;not null" json:"content"`
+	Rendered     *markdown.Document      `gorm:"-"                                  json:"rendered,omitempty"`
+	Tags         []tagEntity.Tag         `gorm:"many2many:article_tags"             json:"tags"`
+	AuthorID     *uint                   `gorm:"index"                              json:"authorId"`
+	Author       *userEntity.Author      `gorm:"foreignKey:AuthorID"                json:"author,omitempty"`
+	CommentCount int64                   `gorm:"not null;default:0"                 json:"commentCount"`
+	Status       ArticleStatus           `gorm:"size:20;default:draft;index"        json:"status"`
+	PublishedAt  *time.Time              `gorm:"index"                              json:"publishedAt"`
+	ScheduledFor *time.Time              `gorm:"index"                              json:"scheduledFor"`
+	Version      uint                    `gorm:"not null;default:1"                 json:"version"`
+	CreatedAt    time.Time               `gorm:"not null;default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt    time.Time               `gorm:"not null;default:CURRENT_TIMESTAMP" json:"updatedAt"`
+	DeletedAt    gorm.DeletedAt          `gorm:"index"                              json:"deletedAt"`
+}
+
+func NewArticle(
+	category *categoryEntity.Category,
+	title, content string,
+	tags []tagEntity.Tag,
+	now time.Time,
+) (*Article, error) {
 	if category == nil {
 		return nil, errors.ErrCategoryRequired
 	}
@@ -36,13 +55,31 @@ func NewArticle(category *categoryEntity.Category, title, content string, tags [
 		CategoryID: category.ID,
 		Category:   *category,
 		Title:      title,
+		Slug:       slug.Make(title),
 		Content:    content,
 		Tags:       tags,
-		CreatedAt:  time.Now(),
-		UpdatedAt:  time.Now(),
+		Status:     StatusDraft,
+		CreatedAt:  now,
+		UpdatedAt:  now,
 	}, nil
 }
 
+// AssignAuthor records the user who owns the article.
+func (a *Article) AssignAuthor(userID uint) {
+	a.AuthorID = &userID
+}
+
+// ChangeSlug replaces the slug the article is addressed by and returns the
+// previous one. Titles may change freely; the slug only changes through here.
+func (a *Article) ChangeSlug(s string) (string, error) {
+	if !slug.Valid(s) {
+		return "", errors.ErrInvalidSlug
+	}
+	previous := a.Slug
+	a.Slug = s
+	return previous, nil
+}
+
 func (a *Article) AddTag(tag tagEntity.Tag) error {
 	for _, existingTag := range a.Tags {
 		if existingTag.ID == tag.ID {
@@ -54,7 +91,12 @@ func (a *Article) AddTag(tag tagEntity.Tag) error {
 	return nil
 }
 
-func (a *Article) Update(req *dto.UpdateArticleRequest, category *categoryEntity.Category, tags []tagEntity.Tag) {
+func (a *Article) Update(
+	req *dto.UpdateArticleRequest,
+	category *categoryEntity.Category,
+	tags []tagEntity.Tag,
+	now time.Time,
+) {
 	if category != nil {
 		a.CategoryID = category.ID
 		a.Category = *category
@@ -68,7 +110,93 @@ func (a *Article) Update(req *dto.UpdateArticleRequest, category *categoryEntity
 	if tags != nil {
 		a.Tags = tags
 	}
-	a.UpdatedAt = time.Now()
+	a.UpdatedAt = now
+}
+
+// RestoreRevision replaces the title and content with those of a revision.
+func (a *Article) RestoreRevision(revision *ArticleRevision, now time.Time) error {
+	if revision == nil || revision.ArticleID != a.ID {
+		return errors.ErrRevisionMismatch
+	}
+	a.Title = revision.Title
+	a.Content = revision.Content
+	a.UpdatedAt = now
+	return nil
+}
+
+// SubmitForReview moves the article into the review queue.
+func (a *Article) SubmitForReview(now time.Time) error {
+	return a.transitionTo(StatusInReview, now)
+}
+
+// Publish makes the article public immediately.
+func (a *Article) Publish(now time.Time) error {
+	if err := a.transitionTo(StatusPublished, now); err != nil {
+		return err
+	}
+	a.PublishedAt = &now
+	a.ScheduledFor = nil
+	return nil
+}
+
+// Schedule marks the article to be published at the given time.
+func (a *Article) Schedule(at, now time.Time) error {
+	if !at.After(now) {
+		return errors.ErrScheduleInPast
+	}
+	if a.Status != StatusScheduled {
+		if err := a.transitionTo(StatusScheduled, now); err != nil {
+			return err
+		}
+	}
+	a.ScheduledFor = &at
+	return nil
+}
+
+// Unpublish takes a published or scheduled article back to draft.
+func (a *Article) Unpublish(now time.Time) error {
+	if a.Status != StatusPublished && a.Status != StatusScheduled {
+		return errors.ErrInvalidStatusTransition
+	}
+	if err := a.transitionTo(StatusDraft, now); err != nil {
+		return err
+	}
+	a.PublishedAt = nil
+	a.ScheduledFor = nil
+	return nil
+}
+
+// Archive retires the article from public listings.
+func (a *Article) Archive(now time.Time) error {
+	if err := a.transitionTo(StatusArchived, now); err != nil {
+		return err
+	}
+	a.ScheduledFor = nil
+	return nil
+}
+
+// IsDue reports whether a scheduled article should be published at now.
+func (a *Article) IsDue(now time.Time) bool {
+	return a.Status == StatusScheduled && a.ScheduledFor != nil && !a.ScheduledFor.After(now)
+}
+
+// IsPublished reports whether the article is publicly visible.
+func (a *Article) IsPublished() bool {
+	return a.Status == StatusPublished
+}
+
+// transitionTo changes the status if the transition is allowed.
+func (a *Article) transitionTo(target ArticleStatus, now time.Time) error {
+	current := a.Status
+	if current == "" {
+		current = StatusDraft
+	}
+	if !current.CanTransitionTo(target) {
+		return errors.ErrInvalidStatusTransition
+	}
+	a.Status = target
+	a.UpdatedAt = now
+	return nil
 }
 
 // GetID get article id, implement Entity interface.
@@ -76,6 +204,27 @@ func (a Article) GetID() uint {
 	return a.ID
 }
 
+// GetUpdatedAt get article last modification time, implement Timestamped interface.
+func (a Article) GetUpdatedAt() time.Time {
+	return a.UpdatedAt
+}
+
+// EmbedsDerivedData reports that the article carries its comment count, category, tags, author and rendered content,
+// implement Composite interface.
+func (Article) EmbedsDerivedData() bool {
+	return true
+}
+
+// GetVersion get article version, implement Versioned interface.
+func (a Article) GetVersion() uint {
+	return a.Version
+}
+
+// SetVersion set article version, implement Versioned interface.
+func (a *Article) SetVersion(version uint) {
+	a.Version = version
+}
+
 func (a *Article) GetFieldValue(field string) string {
 	switch field {
 	case "Title":
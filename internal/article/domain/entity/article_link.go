@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// ArticleLink is what it takes to link to an article, without its content or
+// associations.
+type ArticleLink struct {
+	ID        uint
+	Slug      string
+	UpdatedAt time.Time
+}
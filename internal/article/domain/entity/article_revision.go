@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// ArticleRevision is an immutable snapshot of an article's title and content.
+type ArticleRevision struct {
+	ID        uint      `gorm:"primaryKey"                                json:"id"`
+	ArticleID uint      `gorm:"not null;uniqueIndex:idx_article_revision" json:"articleId"`
+	Number    uint      `gorm:"not null;uniqueIndex:idx_article_revision" json:"number"`
+	Title     string    `gorm:"size:255;not null"                         json:"title"`
+	Content   string    `gorm:"type:text;not null"                        json:"content"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"        json:"createdAt"`
+}
+
+// NewArticleRevision snapshots the current state of the article.
+func NewArticleRevision(article *Article, number uint, now time.Time) *ArticleRevision {
+	return &ArticleRevision{
+		ArticleID: article.ID,
+		Number:    number,
+		Title:     article.Title,
+		Content:   article.Content,
+		CreatedAt: now,
+	}
+}
+
+// GetID get revision id, implement Entity interface.
+func (r ArticleRevision) GetID() uint {
+	return r.ID
+}
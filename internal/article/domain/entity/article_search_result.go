@@ -0,0 +1,15 @@
+package entity
+
+// ArticleSearchResult is an article matched by full-text search, with its
+// relevance and highlighted snippets.
+type ArticleSearchResult struct {
+	Article    *Article        `json:"article"`
+	Rank       float64         `json:"rank"`
+	Highlights SearchHighlight `json:"highlights"`
+}
+
+// SearchHighlight holds snippets with matches wrapped in <mark> tags.
+type SearchHighlight struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
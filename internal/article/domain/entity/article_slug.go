@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// ArticleSlugHistory records a slug an article was renamed away from, so
+// links using it keep resolving to the article.
+type ArticleSlugHistory struct {
+	ID        uint      `gorm:"primaryKey"                         json:"id"`
+	ArticleID uint      `gorm:"not null;index"                     json:"articleId"`
+	Slug      string    `gorm:"size:100;not null;uniqueIndex"      json:"slug"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP" json:"createdAt"`
+}
+
+// TableName keeps the table name singular, as it holds one history.
+func (ArticleSlugHistory) TableName() string {
+	return "article_slug_history"
+}
+
+// GetID get slug history id, implement Entity interface.
+func (h ArticleSlugHistory) GetID() uint {
+	return h.ID
+}
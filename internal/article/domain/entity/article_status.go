@@ -0,0 +1,37 @@
+package entity
+
+// ArticleStatus represents the publishing state of an article.
+type ArticleStatus string
+
+const (
+	StatusDraft     ArticleStatus = "draft"
+	StatusInReview  ArticleStatus = "in_review"
+	StatusScheduled ArticleStatus = "scheduled"
+	StatusPublished ArticleStatus = "published"
+	StatusArchived  ArticleStatus = "archived"
+)
+
+// allowedTransitions lists the legal target states for each state.
+var allowedTransitions = map[ArticleStatus][]ArticleStatus{
+	StatusDraft:     {StatusInReview, StatusScheduled, StatusPublished, StatusArchived},
+	StatusInReview:  {StatusDraft, StatusScheduled, StatusPublished, StatusArchived},
+	StatusScheduled: {StatusDraft, StatusPublished, StatusArchived},
+	StatusPublished: {StatusDraft, StatusArchived},
+	StatusArchived:  {StatusDraft},
+}
+
+// IsValid reports whether the status is one of the known states.
+func (s ArticleStatus) IsValid() bool {
+	_, ok := allowedTransitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether moving from s to target is allowed.
+func (s ArticleStatus) CanTransitionTo(target ArticleStatus) bool {
+	for _, allowed := range allowedTransitions[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+// ScheduledPublishRun records one execution of the scheduled publisher.
+type ScheduledPublishRun struct {
+	ID         uint      `gorm:"primaryKey"         json:"id"`
+	Instance   string    `gorm:"size:255;not null"  json:"instance"`
+	StartedAt  time.Time `gorm:"not null;index"     json:"startedAt"`
+	FinishedAt time.Time `gorm:"not null"           json:"finishedAt"`
+	Published  int       `gorm:"not null;default:0" json:"published"`
+	Failed     int       `gorm:"not null;default:0" json:"failed"`
+	Error      string    `gorm:"type:text"          json:"error"`
+}
+
+// NewScheduledPublishRun starts a run record for the given instance.
+func NewScheduledPublishRun(instance string, startedAt time.Time) *ScheduledPublishRun {
+	return &ScheduledPublishRun{
+		Instance:  instance,
+		StartedAt: startedAt,
+	}
+}
+
+// Finish closes the run with its outcome.
+func (r *ScheduledPublishRun) Finish(finishedAt time.Time, published, failed int, err error) {
+	r.FinishedAt = finishedAt
+	r.Published = published
+	r.Failed = failed
+	if err != nil {
+		r.Error = err.Error()
+	}
+}
+
+// GetID get run id, implement Entity interface.
+func (r ScheduledPublishRun) GetID() uint {
+	return r.ID
+}
@@ -0,0 +1,137 @@
+// Package markdown renders article sources written in CommonMark with the
+// GitHub extensions into sanitized HTML.
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Document is the rendered form of a source.
+type Document struct {
+	HTML string    `json:"html"`
+	TOC  []Heading `json:"toc"`
+}
+
+// Heading is an entry of the table of contents, linking to the heading's
+// anchor.
+type Heading struct {
+	Level int    `json:"level"`
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+var (
+	// classNames matches the classes of highlighted code and footnotes.
+	classNames = regexp.MustCompile(`^[a-zA-Z0-9_ -]+$`)
+	// anchors matches generated heading and footnote ids.
+	anchors = regexp.MustCompile(`^[a-zA-Z0-9_:-]+$`)
+)
+
+// Renderer converts Markdown to HTML. Raw HTML in the source is dropped and
+// the output is sanitized, so the result is safe to embed in a page. Code
+// blocks are highlighted with CSS classes, leaving the theme to the client.
+// A Renderer is safe for concurrent use.
+type Renderer struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+}
+
+func NewRenderer() *Renderer {
+	return &Renderer{
+		markdown: goldmark.New(
+			goldmark.WithExtensions(
+				extension.GFM,
+				extension.Footnote,
+				highlighting.NewHighlighting(
+					highlighting.WithFormatOptions(chromahtml.WithClasses(true)),
+				),
+			),
+			goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		),
+		policy: newPolicy(),
+	}
+}
+
+// Render converts source and collects its headings.
+func (r *Renderer) Render(source string) (*Document, error) {
+	src := []byte(source)
+	doc := r.markdown.Parser().Parse(text.NewReader(src))
+
+	var buf bytes.Buffer
+	if err := r.markdown.Renderer().Render(&buf, src, doc); err != nil {
+		return nil, fmt.Errorf("failed to render markdown: %w", err)
+	}
+
+	return &Document{
+		HTML: r.policy.Sanitize(buf.String()),
+		TOC:  headings(doc, src),
+	}, nil
+}
+
+// newPolicy extends the policy for user generated content with what the
+// extensions emit: highlighting classes, heading and footnote anchors and
+// task list checkboxes.
+func newPolicy() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	policy.AllowAttrs("class").Matching(classNames).OnElements("pre", "code", "span", "div", "a", "li", "hr")
+	policy.AllowAttrs("id").Matching(anchors).OnElements("h1", "h2", "h3", "h4", "h5", "h6", "li", "sup")
+	policy.AllowAttrs("role").Matching(regexp.MustCompile(`^doc-[a-z]+$`)).OnElements("a", "div", "li")
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+	return policy
+}
+
+// headings lists the headings of doc in document order.
+func headings(doc ast.Node, source []byte) []Heading {
+	toc := []Heading{}
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		var id string
+		if value, ok := heading.AttributeString("id"); ok {
+			if b, ok := value.([]byte); ok {
+				id = string(b)
+			}
+		}
+		toc = append(toc, Heading{
+			Level: heading.Level,
+			ID:    id,
+			Title: plainText(heading, source),
+		})
+		return ast.WalkSkipChildren, nil
+	})
+	return toc
+}
+
+// plainText concatenates the text below n, dropping any formatting.
+func plainText(n ast.Node, source []byte) string {
+	var buf bytes.Buffer
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		switch node := child.(type) {
+		case *ast.Text:
+			buf.Write(node.Segment.Value(source))
+			if node.SoftLineBreak() || node.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(node.Value)
+		default:
+			buf.WriteString(plainText(child, source))
+		}
+	}
+	return buf.String()
+}
@@ -1,6 +1,8 @@
 package query
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 
 	baseQuery "github.com/jambo0624/blog/internal/shared/domain/query"
@@ -10,15 +12,26 @@ import (
 const (
 	PreloadCategory = "Category"
 	PreloadTags     = "Tags"
+	PreloadAuthor   = "Author"
+)
+
+// Status values accepted by the status filter.
+const (
+	StatusPublished = "published"
 )
 
 type ArticleQuery struct {
 	baseQuery.BaseQuery
-	CategoryID          *uint    `binding:"omitempty"          json:"categoryId"          validate:"omitempty,gt=0"`
-	TagIDs              []uint   `binding:"omitempty"          json:"tagIds"              validate:"omitempty,dive,gt=0"`
-	TitleLike           string   `binding:"omitempty"          json:"titleLike"           validate:"omitempty,max=255"`
-	ContentLike         string   `binding:"omitempty, max=255" json:"contentLike"         validate:"omitempty,max=255"`
-	PreloadAssociations []string `binding:"omitempty"          json:"preloadAssociations"`
+	CategoryID          *uint      `binding:"omitempty"          json:"categoryId"          validate:"omitempty,gt=0"`
+	IncludeDescendants  bool       `binding:"omitempty"          json:"includeDescendants"`
+	TagIDs              []uint     `binding:"omitempty"          json:"tagIds"              validate:"omitempty,dive,gt=0"`
+	AuthorID            *uint      `binding:"omitempty"          json:"authorId"            validate:"omitempty,gt=0"`
+	TitleLike           string     `binding:"omitempty"          json:"titleLike"           validate:"omitempty,max=255"`
+	ContentLike         string     `binding:"omitempty, max=255" json:"contentLike"         validate:"omitempty,max=255"`
+	Statuses            []string   `binding:"omitempty"          json:"statuses"            validate:"omitempty,dive,oneof=draft in_review scheduled published archived"`
+	ScheduledBefore     *time.Time `binding:"omitempty"          json:"scheduledBefore"`
+	Search              string     `binding:"omitempty"          json:"search"              validate:"omitempty,max=1000"`
+	PreloadAssociations []string   `binding:"omitempty"          json:"preloadAssociations"`
 }
 
 func NewArticleQuery() *ArticleQuery {
@@ -34,12 +47,26 @@ func (q *ArticleQuery) WithCategoryID(id uint) *ArticleQuery {
 	return q
 }
 
+// WithDescendants extends the category filter to every category nested
+// below CategoryID.
+func (q *ArticleQuery) WithDescendants() *ArticleQuery {
+	q.IncludeDescendants = true
+
+	return q
+}
+
 func (q *ArticleQuery) WithTagIDs(ids []uint) *ArticleQuery {
 	q.TagIDs = ids
 
 	return q
 }
 
+func (q *ArticleQuery) WithAuthorID(id uint) *ArticleQuery {
+	q.AuthorID = &id
+
+	return q
+}
+
 func (q *ArticleQuery) WithTitleLike(title string) *ArticleQuery {
 	q.TitleLike = title
 
@@ -52,6 +79,38 @@ func (q *ArticleQuery) WithContentLike(content string) *ArticleQuery {
 	return q
 }
 
+func (q *ArticleQuery) WithStatuses(statuses ...string) *ArticleQuery {
+	q.Statuses = statuses
+
+	return q
+}
+
+// WithScheduledBefore restricts the query to articles scheduled at or before t.
+func (q *ArticleQuery) WithScheduledBefore(t time.Time) *ArticleQuery {
+	q.ScheduledBefore = &t
+
+	return q
+}
+
+// WithSearch adds a full-text search condition. tsQuery must be in Postgres
+// tsquery syntax, as produced by ToTSQuery.
+func (q *ArticleQuery) WithSearch(tsQuery string) *ArticleQuery {
+	q.Search = tsQuery
+
+	return q
+}
+
+// WithPublishedOnly restricts the query to publicly visible articles.
+func (q *ArticleQuery) WithPublishedOnly() *ArticleQuery {
+	return q.WithStatuses(StatusPublished)
+}
+
+// IsPublishedOnly reports whether the query is restricted to publicly
+// visible articles.
+func (q *ArticleQuery) IsPublishedOnly() bool {
+	return len(q.Statuses) == 1 && q.Statuses[0] == StatusPublished
+}
+
 func (q *ArticleQuery) Validate() error {
 	return q.BaseQuery.ValidateQuery(q)
 }
@@ -66,7 +125,11 @@ func (q *ArticleQuery) GetPreloadAssociations() []string {
 
 func (q *ArticleQuery) ApplyFilters(db *gorm.DB) *gorm.DB {
 	if q.CategoryID != nil {
-		db = db.Where("category_id = ?", q.CategoryID)
+		if q.IncludeDescendants {
+			db = db.Where("category_id IN (?)", categorySubtree(db, *q.CategoryID))
+		} else {
+			db = db.Where("category_id = ?", q.CategoryID)
+		}
 	}
 
 	if len(q.TagIDs) > 0 {
@@ -75,6 +138,10 @@ func (q *ArticleQuery) ApplyFilters(db *gorm.DB) *gorm.DB {
 			Group("articles.id")
 	}
 
+	if q.AuthorID != nil {
+		db = db.Where("articles.author_id = ?", q.AuthorID)
+	}
+
 	if q.TitleLike != "" {
 		db = db.Where("title LIKE ?", "%"+q.TitleLike+"%")
 	}
@@ -83,10 +150,34 @@ func (q *ArticleQuery) ApplyFilters(db *gorm.DB) *gorm.DB {
 		db = db.Where("content LIKE ?", "%"+q.ContentLike+"%")
 	}
 
+	if len(q.Statuses) > 0 {
+		db = db.Where("articles.status IN ?", q.Statuses)
+	}
+
+	if q.Search != "" {
+		db = db.Where("articles.search_vector @@ to_tsquery(?, ?)", SearchConfig, q.Search)
+	}
+
+	if q.ScheduledBefore != nil {
+		db = db.Where("articles.scheduled_for <= ?", q.ScheduledBefore)
+	}
+
 	return db
 }
 
 // getDefaultPreloads returns default preload associations for Article queries.
 func getDefaultPreloads() []string {
-	return []string{PreloadCategory, PreloadTags}
+	return []string{PreloadCategory, PreloadTags, PreloadAuthor}
+}
+
+// categorySubtree selects categoryID and the IDs of all its descendants.
+// UNION rather than UNION ALL keeps the recursion finite on bad data.
+func categorySubtree(db *gorm.DB, categoryID uint) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Raw(`
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM categories WHERE id = ?
+			UNION
+			SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+		)
+		SELECT id FROM subtree`, categoryID)
 }
@@ -0,0 +1,133 @@
+package query
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+// SearchConfig is the Postgres text search configuration used for articles.
+const SearchConfig = "english"
+
+// ToTSQuery converts a user search string into Postgres tsquery syntax.
+//
+// Terms are ANDed together. "quoted phrases" match adjacent words, a trailing
+// * makes a prefix match, a leading - excludes a term and OR between terms
+// matches either of them.
+func ToTSQuery(input string) (string, error) {
+	var parts []string
+	pendingOr := false
+
+	for _, token := range tokenize(input) {
+		if token == "OR" {
+			pendingOr = len(parts) > 0
+			continue
+		}
+
+		term := buildTerm(token)
+		if term == "" {
+			continue
+		}
+
+		if len(parts) > 0 {
+			op := " & "
+			if pendingOr {
+				op = " | "
+			}
+			parts = append(parts, op)
+		}
+		parts = append(parts, term)
+		pendingOr = false
+	}
+
+	if len(parts) == 0 {
+		return "", errors.ErrInvalidSearchQuery
+	}
+	return strings.Join(parts, ""), nil
+}
+
+// tokenize splits the input on whitespace, keeping quoted phrases together.
+func tokenize(input string) []string {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range input {
+		switch {
+		case r == '"':
+			if inQuotes {
+				current.WriteRune(r)
+				flush()
+			} else {
+				flush()
+				current.WriteRune(r)
+			}
+			inQuotes = !inQuotes
+		case unicode.IsSpace(r) && !inQuotes:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// buildTerm turns a single token into a tsquery operand.
+func buildTerm(token string) string {
+	negate := strings.HasPrefix(token, "-")
+	token = strings.TrimPrefix(token, "-")
+
+	var term string
+	if strings.HasPrefix(token, `"`) {
+		words := sanitizeWords(strings.Fields(strings.Trim(token, `"`)))
+		if len(words) == 0 {
+			return ""
+		}
+		term = strings.Join(words, " <-> ")
+		if len(words) > 1 {
+			term = "(" + term + ")"
+		}
+	} else {
+		prefix := strings.HasSuffix(token, "*")
+		words := sanitizeWords([]string{strings.TrimSuffix(token, "*")})
+		if len(words) == 0 {
+			return ""
+		}
+		term = words[0]
+		if prefix {
+			term += ":*"
+		}
+	}
+
+	if negate {
+		return "!" + term
+	}
+	return term
+}
+
+// sanitizeWords strips characters that carry meaning in tsquery syntax.
+func sanitizeWords(words []string) []string {
+	sanitized := make([]string, 0, len(words))
+	for _, w := range words {
+		clean := strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, w)
+		if clean != "" {
+			sanitized = append(sanitized, clean)
+		}
+	}
+	return sanitized
+}
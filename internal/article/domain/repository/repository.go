@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
 	articleQuery "github.com/jambo0624/blog/internal/article/domain/query"
 	"github.com/jambo0624/blog/internal/shared/domain/repository"
@@ -8,4 +10,36 @@ import (
 
 type ArticleRepository interface {
 	repository.BaseRepository[articleEntity.Article, *articleQuery.ArticleQuery]
+	Search(ctx context.Context, query *articleQuery.ArticleQuery) ([]*articleEntity.ArticleSearchResult, int64, error)
+	// UpdateCommentCount stores the number of approved comments on the
+	// article without touching its other columns.
+	UpdateCommentCount(ctx context.Context, id uint, count int64) error
+	// FindBySlug finds the article currently addressed by slug.
+	FindBySlug(ctx context.Context, slug string, preloadAssociations ...string) (*articleEntity.Article, error)
+	// FindByPreviousSlug finds the article that was renamed away from slug.
+	FindByPreviousSlug(ctx context.Context, slug string) (*articleEntity.Article, error)
+	// SlugTaken reports whether slug addresses, or used to address, an
+	// article other than exceptID. Trashed articles keep their slugs.
+	SlugTaken(ctx context.Context, slug string, exceptID uint) (bool, error)
+	// RecordSlugChange keeps previous resolving to the article and releases
+	// current from the history, where the article may have used it before.
+	RecordSlugChange(ctx context.Context, articleID uint, previous, current string) error
+	// FindPublishedLinks pages through the published articles by id, loading
+	// only what it takes to link to them.
+	FindPublishedLinks(ctx context.Context, limit, offset int) ([]*articleEntity.ArticleLink, error)
+	// CountPublished counts the published articles.
+	CountPublished(ctx context.Context) (int64, error)
+}
+
+// ArticleRevisionRepository stores the revision history of articles.
+type ArticleRevisionRepository interface {
+	Save(ctx context.Context, revision *articleEntity.ArticleRevision) error
+	FindByArticleID(ctx context.Context, articleID uint) ([]*articleEntity.ArticleRevision, error)
+	FindByNumber(ctx context.Context, articleID, number uint) (*articleEntity.ArticleRevision, error)
+	LatestNumber(ctx context.Context, articleID uint) (uint, error)
+}
+
+// ScheduledPublishRunRepository stores the history of scheduled publisher runs.
+type ScheduledPublishRunRepository interface {
+	Save(ctx context.Context, run *articleEntity.ScheduledPublishRun) error
 }
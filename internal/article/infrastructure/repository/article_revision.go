@@ -0,0 +1,50 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	articleRepository "github.com/jambo0624/blog/internal/article/domain/repository"
+	persistence "github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
+)
+
+type GormArticleRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewGormArticleRevisionRepository(db *gorm.DB) articleRepository.ArticleRevisionRepository {
+	return &GormArticleRevisionRepository{db: db}
+}
+
+func (r *GormArticleRevisionRepository) Save(ctx context.Context, revision *articleEntity.ArticleRevision) error {
+	return persistence.Conn(ctx, r.db).Create(revision).Error
+}
+
+func (r *GormArticleRevisionRepository) FindByArticleID(ctx context.Context, articleID uint) ([]*articleEntity.ArticleRevision, error) {
+	var revisions []*articleEntity.ArticleRevision
+	err := persistence.Conn(ctx, r.db).Where("article_id = ?", articleID).Order("number DESC").Find(&revisions).Error
+	if err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (r *GormArticleRevisionRepository) FindByNumber(ctx context.Context, articleID, number uint) (*articleEntity.ArticleRevision, error) {
+	var revision articleEntity.ArticleRevision
+	err := persistence.Conn(ctx, r.db).Where("article_id = ? AND number = ?", articleID, number).First(&revision).Error
+	if err != nil {
+		return nil, persistence.TranslateError(err)
+	}
+	return &revision, nil
+}
+
+func (r *GormArticleRevisionRepository) LatestNumber(ctx context.Context, articleID uint) (uint, error) {
+	var number uint
+	err := persistence.Conn(ctx, r.db).Model(&articleEntity.ArticleRevision{}).
+		Where("article_id = ?", articleID).
+		Select("COALESCE(MAX(number), 0)").
+		Scan(&number).Error
+	return number, err
+}
@@ -1,7 +1,12 @@
 package persistence
 
 import (
+	"context"
+	"html"
+	"strings"
+
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
 	articleQuery "github.com/jambo0624/blog/internal/article/domain/query"
@@ -9,12 +14,219 @@ import (
 	persistence "github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
 )
 
+// Matches are delimited with control characters rather than <mark> tags so the
+// snippet can be HTML-escaped before the tags are put in place.
+const (
+	highlightStart = "\x01"
+	highlightStop  = "\x02"
+)
+
+// headlineOptions configure ts_headline snippets.
+const (
+	titleHeadlineOptions   = "StartSel=" + highlightStart + ", StopSel=" + highlightStop + ", HighlightAll=true"
+	contentHeadlineOptions = "StartSel=" + highlightStart + ", StopSel=" + highlightStop + ", MaxFragments=2, MaxWords=30, MinWords=10"
+)
+
+// highlightMarker turns the escaped match delimiters into <mark> tags.
+var highlightMarker = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// markHighlight escapes a ts_headline snippet and wraps its matches in <mark>
+// tags, so markup stored in the article is never rendered.
+func markHighlight(snippet string) string {
+	return highlightMarker.Replace(html.EscapeString(snippet))
+}
+
 type GormArticleRepository struct {
 	*persistence.BaseGormRepository[articleEntity.Article, *articleQuery.ArticleQuery]
+	db *gorm.DB
 }
 
 func NewGormArticleRepository(db *gorm.DB) articleRepository.ArticleRepository {
 	return &GormArticleRepository{
 		BaseGormRepository: persistence.NewBaseGormRepository[articleEntity.Article, *articleQuery.ArticleQuery](db),
+		db:                 db,
+	}
+}
+
+// searchRow is the ranked match returned by the search query.
+type searchRow struct {
+	ID               uint
+	Rank             float64
+	TitleHighlight   string
+	ContentHighlight string
+}
+
+// Search runs a full-text search ordered by relevance. The query must carry a
+// search term set through WithSearch.
+func (r *GormArticleRepository) Search(ctx context.Context, q *articleQuery.ArticleQuery) ([]*articleEntity.ArticleSearchResult, int64, error) {
+	var total int64
+
+	query := q.ApplyFilters(persistence.Conn(ctx, r.db).Model(&articleEntity.Article{}))
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []searchRow
+	err := query.
+		Select(
+			`articles.id,
+			ts_rank(articles.search_vector, to_tsquery(?, ?)) AS rank,
+			ts_headline(?, articles.title, to_tsquery(?, ?), ?) AS title_highlight,
+			ts_headline(?, articles.content, to_tsquery(?, ?), ?) AS content_highlight`,
+			articleQuery.SearchConfig, q.Search,
+			articleQuery.SearchConfig, articleQuery.SearchConfig, q.Search, titleHeadlineOptions,
+			articleQuery.SearchConfig, articleQuery.SearchConfig, q.Search, contentHeadlineOptions,
+		).
+		Order("rank DESC, articles.id DESC").
+		Limit(q.Limit).
+		Offset(q.Offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results, err := r.loadSearchResults(ctx, rows, q.GetPreloadAssociations())
+	if err != nil {
+		return nil, 0, err
+	}
+	return results, total, nil
+}
+
+// loadSearchResults loads the matched articles, keeping the ranked order.
+func (r *GormArticleRepository) loadSearchResults(
+	ctx context.Context,
+	rows []searchRow,
+	preloads []string,
+) ([]*articleEntity.ArticleSearchResult, error) {
+	if len(rows) == 0 {
+		return []*articleEntity.ArticleSearchResult{}, nil
+	}
+
+	ids := make([]uint, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	query := persistence.Conn(ctx, r.db).Model(&articleEntity.Article{})
+	for _, preload := range preloads {
+		query = query.Preload(preload)
+	}
+	var articles []*articleEntity.Article
+	if err := query.Where("id IN ?", ids).Find(&articles).Error; err != nil {
+		return nil, err
 	}
+
+	byID := make(map[uint]*articleEntity.Article, len(articles))
+	for _, article := range articles {
+		byID[article.ID] = article
+	}
+
+	results := make([]*articleEntity.ArticleSearchResult, 0, len(rows))
+	for _, row := range rows {
+		article, ok := byID[row.ID]
+		if !ok {
+			continue
+		}
+		results = append(results, &articleEntity.ArticleSearchResult{
+			Article: article,
+			Rank:    row.Rank,
+			Highlights: articleEntity.SearchHighlight{
+				Title:   markHighlight(row.TitleHighlight),
+				Content: markHighlight(row.ContentHighlight),
+			},
+		})
+	}
+	return results, nil
+}
+
+func (r *GormArticleRepository) UpdateCommentCount(ctx context.Context, id uint, count int64) error {
+	return persistence.Conn(ctx, r.db).Model(&articleEntity.Article{}).
+		Where("id = ?", id).
+		UpdateColumn("comment_count", count).Error
+}
+
+func (r *GormArticleRepository) FindBySlug(
+	ctx context.Context,
+	slug string,
+	preloadAssociations ...string,
+) (*articleEntity.Article, error) {
+	query := persistence.Conn(ctx, r.db).Model(&articleEntity.Article{})
+	for _, preload := range preloadAssociations {
+		query = query.Preload(preload)
+	}
+	var article articleEntity.Article
+	if err := query.Where("slug = ?", slug).First(&article).Error; err != nil {
+		return nil, persistence.TranslateError(err)
+	}
+	return &article, nil
+}
+
+func (r *GormArticleRepository) FindByPreviousSlug(ctx context.Context, slug string) (*articleEntity.Article, error) {
+	var article articleEntity.Article
+	err := persistence.Conn(ctx, r.db).
+		Where("id = (?)", persistence.Conn(ctx, r.db).Model(&articleEntity.ArticleSlugHistory{}).
+			Select("article_id").
+			Where("slug = ?", slug)).
+		First(&article).Error
+	if err != nil {
+		return nil, persistence.TranslateError(err)
+	}
+	return &article, nil
+}
+
+func (r *GormArticleRepository) SlugTaken(ctx context.Context, slug string, exceptID uint) (bool, error) {
+	var taken bool
+	err := persistence.Conn(ctx, r.db).Raw(
+		`SELECT EXISTS (SELECT 1 FROM articles WHERE slug = ? AND id <> ?)
+			OR EXISTS (SELECT 1 FROM article_slug_history WHERE slug = ? AND article_id <> ?)`,
+		slug, exceptID, slug, exceptID,
+	).Scan(&taken).Error
+	return taken, err
+}
+
+func (r *GormArticleRepository) RecordSlugChange(ctx context.Context, articleID uint, previous, current string) error {
+	db := persistence.Conn(ctx, r.db)
+	if err := db.Where("slug = ?", current).Delete(&articleEntity.ArticleSlugHistory{}).Error; err != nil {
+		return err
+	}
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "slug"}},
+		DoUpdates: clause.AssignmentColumns([]string{"article_id", "created_at"}),
+	}).Create(&articleEntity.ArticleSlugHistory{ArticleID: articleID, Slug: previous}).Error
+}
+
+func (r *GormArticleRepository) FindPublishedLinks(ctx context.Context, limit, offset int) ([]*articleEntity.ArticleLink, error) {
+	var links []*articleEntity.ArticleLink
+	err := r.published(ctx).
+		Select("id, slug, updated_at").
+		Order("id").
+		Limit(limit).
+		Offset(offset).
+		Scan(&links).Error
+	return links, err
+}
+
+func (r *GormArticleRepository) CountPublished(ctx context.Context) (int64, error) {
+	var total int64
+	err := r.published(ctx).Count(&total).Error
+	return total, err
+}
+
+// published scopes a query to the published articles still out of the trash.
+func (r *GormArticleRepository) published(ctx context.Context) *gorm.DB {
+	return persistence.Conn(ctx, r.db).Model(&articleEntity.Article{}).
+		Where("status = ?", articleEntity.StatusPublished)
+}
+
+// Purge permanently removes a trashed article along with its tag links,
+// revisions, slug history and comments.
+func (r *GormArticleRepository) Purge(ctx context.Context, id uint) error {
+	return r.PurgeWith(ctx, id, func(tx *gorm.DB) error {
+		for _, table := range []string{"article_tags", "article_revisions", "article_slug_history", "comments"} {
+			if err := tx.Exec("DELETE FROM "+table+" WHERE article_id = ?", id).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
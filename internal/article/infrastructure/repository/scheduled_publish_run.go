@@ -0,0 +1,23 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	articleRepository "github.com/jambo0624/blog/internal/article/domain/repository"
+	persistence "github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
+)
+
+type GormScheduledPublishRunRepository struct {
+	db *gorm.DB
+}
+
+func NewGormScheduledPublishRunRepository(db *gorm.DB) articleRepository.ScheduledPublishRunRepository {
+	return &GormScheduledPublishRunRepository{db: db}
+}
+
+func (r *GormScheduledPublishRunRepository) Save(ctx context.Context, run *articleEntity.ScheduledPublishRun) error {
+	return persistence.Conn(ctx, r.db).Create(run).Error
+}
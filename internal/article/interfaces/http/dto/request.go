@@ -1,25 +1,53 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	sharedDto "github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+)
+
+// CreateArticleRequest carries AuthorID from the authenticated user, never
+// from the request body. Slug is derived from the title when left empty.
 type CreateArticleRequest struct {
-	Title      string `binding:"required,max=255" json:"title"`
-	Content    string `binding:"required"         json:"content"`
-	CategoryID uint   `binding:"required"         json:"categoryId"`
-	TagIDs     []uint `binding:"omitempty"        json:"tagIds"`
+	Title      string `binding:"required"  json:"title"`
+	Slug       string `binding:"omitempty" json:"slug"`
+	Content    string `binding:"required"  json:"content"`
+	CategoryID uint   `binding:"required"  json:"categoryId"`
+	TagIDs     []uint `binding:"omitempty" json:"tagIds"`
+	AuthorID   uint   `json:"-"`
 }
 
 type UpdateArticleRequest struct {
-	Title      string `binding:"omitempty,max=255" json:"title"`
-	Content    string `binding:"omitempty"         json:"content"`
-	CategoryID uint   `binding:"omitempty"         json:"categoryId"`
-	TagIDs     []uint `binding:"omitempty"         json:"tagIds"`
+	sharedDto.Precondition
+
+	Title      string `binding:"omitempty" json:"title"`
+	Slug       string `binding:"omitempty" json:"slug"`
+	Content    string `binding:"omitempty" json:"content"`
+	CategoryID uint   `binding:"omitempty" json:"categoryId"`
+	TagIDs     []uint `binding:"omitempty" json:"tagIds"`
+}
+
+type ScheduleArticleRequest struct {
+	ScheduledFor time.Time `binding:"required" json:"scheduledFor"`
 }
 
 func (r CreateArticleRequest) Validate() error {
-	// Business rules validation
-	return nil
+	return validateArticle(r.Title, r.Slug)
 }
 
 func (r UpdateArticleRequest) Validate() error {
+	return validateArticle(r.Title, r.Slug)
+}
+
+func validateArticle(title, slug string) error {
+	var errs sharedDto.ValidationErrors
+	errs.CheckLength("title", title, constants.MinTitleLength, constants.MaxTitleLength)
+	errs.CheckSlug("slug", slug)
+	return errs.Err()
+}
+
+func (r ScheduleArticleRequest) Validate() error {
 	// Business rules validation
 	return nil
 }
@@ -0,0 +1,94 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	ID       string      `xml:"id"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomPerson    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Summary    *atomText      `xml:"summary,omitempty"`
+	Content    *atomText      `xml:"content,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Atom renders f as an Atom 1.0 document.
+func Atom(f Feed) ([]byte, error) {
+	doc := atomFeed{
+		Title:    f.Title,
+		Subtitle: f.Description,
+		ID:       f.Self,
+		Updated:  atomTime(f.Updated),
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate"},
+			{Href: f.Self, Rel: "self", Type: mediaTypeAtom},
+		},
+		Entries: make([]atomEntry, 0, len(f.Items)),
+	}
+
+	for _, item := range f.Items {
+		entry := atomEntry{
+			Title:     item.Title,
+			ID:        item.ID,
+			Link:      atomLink{Href: item.Link, Rel: "alternate"},
+			Published: atomTime(item.Published),
+			Updated:   atomTime(item.Updated),
+		}
+		if item.Author != "" {
+			entry.Author = &atomPerson{Name: item.Author}
+		}
+		for _, category := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+		if item.Summary != "" {
+			entry.Summary = &atomText{Type: "text", Value: item.Summary}
+		}
+		switch {
+		case item.ContentHTML != "":
+			entry.Content = &atomText{Type: "html", Value: item.ContentHTML}
+		case item.Content != "":
+			entry.Content = &atomText{Type: "text", Value: item.Content}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	return marshalXML(doc)
+}
+
+func atomTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
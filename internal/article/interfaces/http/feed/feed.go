@@ -0,0 +1,70 @@
+// Package feed renders syndication feeds in RSS 2.0, Atom 1.0 and JSON Feed
+// 1.1 from a format-neutral description.
+package feed
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Media types of the feed formats, as used in self links.
+const (
+	mediaTypeRSS  = "application/rss+xml"
+	mediaTypeAtom = "application/atom+xml"
+	mediaTypeJSON = "application/feed+json"
+)
+
+// Content types of the rendered feeds.
+const (
+	ContentTypeRSS  = mediaTypeRSS + "; charset=utf-8"
+	ContentTypeAtom = mediaTypeAtom + "; charset=utf-8"
+	ContentTypeJSON = mediaTypeJSON + "; charset=utf-8"
+)
+
+// summaryLength is the number of characters kept in an item summary.
+const summaryLength = 280
+
+// Feed describes a feed independently of its format.
+type Feed struct {
+	Title       string
+	Description string
+	Link        string // Page the feed belongs to
+	Self        string // URL of the feed itself
+	Updated     time.Time
+	Items       []Item
+}
+
+// Item is a single entry of a feed. Content and ContentHTML are empty in
+// summary mode; formats that carry markup prefer ContentHTML when set.
+type Item struct {
+	ID          string
+	Title       string
+	Link        string
+	Author      string
+	Summary     string
+	Content     string
+	ContentHTML string
+	Categories  []string
+	Published   time.Time
+	Updated     time.Time
+}
+
+// Summarize reduces content to a plain single-line excerpt of at most
+// summaryLength characters, cut at a word boundary.
+func Summarize(content string) string {
+	text := strings.Join(strings.Fields(content), " ")
+	runes := []rune(text)
+	if len(runes) <= summaryLength {
+		return text
+	}
+
+	cut := summaryLength
+	for cut > 0 && !unicode.IsSpace(runes[cut]) {
+		cut--
+	}
+	if cut == 0 {
+		cut = summaryLength
+	}
+	return strings.TrimRightFunc(string(runes[:cut]), unicode.IsPunct) + "…"
+}
@@ -0,0 +1,70 @@
+package feed
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+type jsonFeed struct {
+	Version     string     `json:"version"`
+	Title       string     `json:"title"`
+	HomePageURL string     `json:"home_page_url"`
+	FeedURL     string     `json:"feed_url"`
+	Description string     `json:"description,omitempty"`
+	Items       []jsonItem `json:"items"`
+}
+
+type jsonItem struct {
+	ID            string       `json:"id"`
+	URL           string       `json:"url"`
+	Title         string       `json:"title"`
+	Summary       string       `json:"summary,omitempty"`
+	ContentHTML   string       `json:"content_html,omitempty"`
+	ContentText   string       `json:"content_text,omitempty"`
+	DatePublished string       `json:"date_published"`
+	DateModified  string       `json:"date_modified"`
+	Authors       []jsonAuthor `json:"authors,omitempty"`
+	Tags          []string     `json:"tags,omitempty"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+}
+
+// JSON renders f as a JSON Feed 1.1 document. Items need content_html or
+// content_text, so in summary mode the summary stands in for them.
+func JSON(f Feed) ([]byte, error) {
+	doc := jsonFeed{
+		Version:     jsonFeedVersion,
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.Self,
+		Description: f.Description,
+		Items:       make([]jsonItem, 0, len(f.Items)),
+	}
+
+	for _, item := range f.Items {
+		entry := jsonItem{
+			ID:            item.ID,
+			URL:           item.Link,
+			Title:         item.Title,
+			Summary:       item.Summary,
+			ContentHTML:   item.ContentHTML,
+			ContentText:   item.Content,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+			DateModified:  item.Updated.UTC().Format(time.RFC3339),
+			Tags:          item.Categories,
+		}
+		if entry.ContentText == "" && entry.ContentHTML == "" {
+			entry.ContentText = item.Summary
+		}
+		if item.Author != "" {
+			entry.Authors = []jsonAuthor{{Name: item.Author}}
+		}
+		doc.Items = append(doc.Items, entry)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
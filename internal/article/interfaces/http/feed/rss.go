@@ -0,0 +1,95 @@
+package feed
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+type rss struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	ContentNS string     `xml:"xmlns:content,attr"`
+	DCNS      string     `xml:"xmlns:dc,attr"`
+	AtomNS    string     `xml:"xmlns:atom,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Self          rssLink   `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	Creator     string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+	Content     string   `xml:"content:encoded,omitempty"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+// RSS renders f as an RSS 2.0 document. The summary goes into description
+// and, in full mode, the content into content:encoded, as HTML when available. RSS expects an email
+// address in author, so the author's name goes into dc:creator instead.
+func RSS(f Feed) ([]byte, error) {
+	channel := rssChannel{
+		Title:       f.Title,
+		Link:        f.Link,
+		Description: f.Description,
+		Self:        rssLink{Href: f.Self, Rel: "self", Type: mediaTypeRSS},
+		Items:       make([]rssItem, 0, len(f.Items)),
+	}
+	if !f.Updated.IsZero() {
+		channel.LastBuildDate = f.Updated.UTC().Format(http.TimeFormat)
+	}
+
+	for _, item := range f.Items {
+		content := item.ContentHTML
+		if content == "" {
+			content = item.Content
+		}
+		channel.Items = append(channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{Value: item.ID, IsPermaLink: item.ID == item.Link},
+			Creator:     item.Author,
+			Categories:  item.Categories,
+			PubDate:     item.Published.UTC().Format(http.TimeFormat),
+			Description: item.Summary,
+			Content:     content,
+		})
+	}
+
+	return marshalXML(rss{
+		Version:   "2.0",
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		DCNS:      "http://purl.org/dc/elements/1.1/",
+		AtomNS:    "http://www.w3.org/2005/Atom",
+		Channel:   channel,
+	})
+}
+
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,214 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	articleService "github.com/jambo0624/blog/internal/article/application/service"
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	articleQuery "github.com/jambo0624/blog/internal/article/domain/query"
+	"github.com/jambo0624/blog/internal/article/interfaces/http/feed"
+	categoryService "github.com/jambo0624/blog/internal/category/application/service"
+	categoryQuery "github.com/jambo0624/blog/internal/category/domain/query"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	sharedHttp "github.com/jambo0624/blog/internal/shared/interfaces/http"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+	tagService "github.com/jambo0624/blog/internal/tag/application/service"
+)
+
+// Feed content modes, chosen with ?content= or FeedOptions.Content.
+const (
+	FeedContentFull    = "full"
+	FeedContentSummary = "summary"
+)
+
+// FeedOptions describes the site the feeds belong to.
+type FeedOptions struct {
+	SiteURL     string // Public base URL without trailing slash
+	Title       string
+	Description string
+	Limit       int    // Number of articles per feed
+	Content     string // Default content mode
+}
+
+// feedFormat pairs a renderer with the content type it produces.
+type feedFormat struct {
+	name        string
+	render      func(feed.Feed) ([]byte, error)
+	contentType string
+}
+
+var (
+	formatRSS  = feedFormat{name: "rss", render: feed.RSS, contentType: feed.ContentTypeRSS}
+	formatAtom = feedFormat{name: "atom", render: feed.Atom, contentType: feed.ContentTypeAtom}
+	formatJSON = feedFormat{name: "json", render: feed.JSON, contentType: feed.ContentTypeJSON}
+)
+
+// feedScope is the part of the site a feed covers.
+type feedScope struct {
+	title string
+	link  string
+	query *articleQuery.ArticleQuery
+}
+
+// FeedHandler serves the latest published articles as RSS, Atom and JSON
+// feeds, for the whole site or a single category or tag.
+type FeedHandler struct {
+	articleService  *articleService.ArticleService
+	categoryService *categoryService.CategoryService
+	tagService      *tagService.TagService
+	options         FeedOptions
+}
+
+func NewFeedHandler(
+	as *articleService.ArticleService,
+	cs *categoryService.CategoryService,
+	ts *tagService.TagService,
+	options FeedOptions,
+) *FeedHandler {
+	return &FeedHandler{
+		articleService:  as,
+		categoryService: cs,
+		tagService:      ts,
+		options:         options,
+	}
+}
+
+// Site handles GET /feed.xml, /atom.xml and /feed.json requests.
+func (h *FeedHandler) Site(format feedFormat) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		h.serve(c, format, feedScope{
+			title: h.options.Title,
+			link:  h.options.SiteURL + "/",
+			query: articleQuery.NewArticleQuery(),
+		})
+	}
+}
+
+// Category handles GET /categories/:slug/<feed> requests, covering the
+// category and every category nested below it.
+func (h *FeedHandler) Category(format feedFormat) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		slug := c.Param("slug")
+		categories, _, err := h.categoryService.FindAll(c.Request.Context(), categoryQuery.NewCategoryQuery().WithSlug(slug))
+		if err != nil {
+			response.InternalError(c, err)
+			return
+		}
+		if len(categories) == 0 {
+			response.NotFound(c)
+			return
+		}
+
+		category := categories[0]
+		h.serve(c, format, feedScope{
+			title: fmt.Sprintf("%s: %s", h.options.Title, category.Name),
+			link:  fmt.Sprintf("%s/categories/%s", h.options.SiteURL, category.Slug),
+			query: articleQuery.NewArticleQuery().WithCategoryID(category.ID).WithDescendants(),
+		})
+	}
+}
+
+// Tag handles GET /tags/:id/<feed> requests.
+func (h *FeedHandler) Tag(format feedFormat) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := sharedHttp.ParseUintParam(c, "id")
+		tag, err := h.tagService.FindByID(c.Request.Context(), id)
+		if err != nil {
+			response.HandleError(c, err)
+			return
+		}
+
+		h.serve(c, format, feedScope{
+			title: fmt.Sprintf("%s: %s", h.options.Title, tag.Name),
+			link:  fmt.Sprintf("%s/tags/%d", h.options.SiteURL, tag.ID),
+			query: articleQuery.NewArticleQuery().WithTagIDs([]uint{tag.ID}),
+		})
+	}
+}
+
+func (h *FeedHandler) serve(c *gin.Context, format feedFormat, scope feedScope) {
+	mode := c.DefaultQuery("content", h.options.Content)
+	if mode != FeedContentFull && mode != FeedContentSummary {
+		response.BadRequest(c, errors.ErrInvalidFeedContent)
+		return
+	}
+
+	q := scope.query.WithPublishedOnly()
+	q.WithPagination(h.options.Limit, 0)
+	q.WithOrderBy("-published_at")
+	q.WithoutCount()
+
+	articles, _, err := h.articleService.FindAll(c.Request.Context(), q)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	self := h.options.SiteURL + c.Request.URL.RequestURI()
+	etag, modified := sharedHttp.ListValidators(articles, []string{format.name, mode, self})
+	if sharedHttp.NotModified(c, etag, modified) {
+		return
+	}
+
+	// only full feeds carry the rendered content
+	if mode == FeedContentFull {
+		h.articleService.Present(articles...)
+	}
+
+	body, err := format.render(h.buildFeed(scope, self, mode, articles))
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+	c.Data(http.StatusOK, format.contentType, body)
+}
+
+func (h *FeedHandler) buildFeed(scope feedScope, self, mode string, articles []*articleEntity.Article) feed.Feed {
+	f := feed.Feed{
+		Title:       scope.title,
+		Description: h.options.Description,
+		Link:        scope.link,
+		Self:        self,
+		Items:       make([]feed.Item, 0, len(articles)),
+	}
+
+	for _, article := range articles {
+		// Items link to the slug URL but keep an ID based on the article id,
+		// so renaming the slug does not show up as a new entry in readers.
+		item := feed.Item{
+			ID:      fmt.Sprintf("%s/articles/%d", h.options.SiteURL, article.ID),
+			Link:    fmt.Sprintf("%s/articles/%s", h.options.SiteURL, article.Slug),
+			Title:   article.Title,
+			Summary: feed.Summarize(article.Content),
+			Updated: article.UpdatedAt,
+		}
+		item.Published = article.UpdatedAt
+		if article.PublishedAt != nil {
+			item.Published = *article.PublishedAt
+		}
+		if mode == FeedContentFull {
+			item.Content = article.Content
+			if article.Rendered != nil {
+				item.ContentHTML = article.Rendered.HTML
+			}
+		}
+		if article.Author != nil {
+			item.Author = article.Author.DisplayName
+		}
+		if article.Category.Name != "" {
+			item.Categories = append(item.Categories, article.Category.Name)
+		}
+		for _, tag := range article.Tags {
+			item.Categories = append(item.Categories, tag.Name)
+		}
+		if item.Updated.After(f.Updated) {
+			f.Updated = item.Updated
+		}
+		f.Items = append(f.Items, item)
+	}
+
+	return f
+}
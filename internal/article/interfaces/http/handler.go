@@ -1,7 +1,11 @@
 package http
 
 import (
+	"net/http"
+	"net/url"
+	"path"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -9,12 +13,17 @@ import (
 	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
 	articleQuery "github.com/jambo0624/blog/internal/article/domain/query"
 	"github.com/jambo0624/blog/internal/article/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
 	"github.com/jambo0624/blog/internal/shared/domain/constants"
 	"github.com/jambo0624/blog/internal/shared/domain/errors"
 	sharedHttp "github.com/jambo0624/blog/internal/shared/interfaces/http"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
 	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
 )
 
+// statusAll is the status filter value that disables status filtering.
+const statusAll = "all"
+
 type ArticleHandler struct {
 	*sharedHttp.BaseHandler[
 		articleEntity.Article,
@@ -22,13 +31,15 @@ type ArticleHandler struct {
 		dto.CreateArticleRequest,
 		dto.UpdateArticleRequest,
 	]
+	articleService *articleService.ArticleService
 }
 
 func NewArticleHandler(as *articleService.ArticleService) *ArticleHandler {
 	baseHandler := sharedHttp.NewBaseHandler(as.BaseService, as)
 
 	return &ArticleHandler{
-		BaseHandler: baseHandler,
+		BaseHandler:    baseHandler,
+		articleService: as,
 	}
 }
 
@@ -48,14 +59,24 @@ func (h *ArticleHandler) buildQuery(c *gin.Context) (*articleQuery.ArticleQuery,
 		return nil, err
 	}
 
+	if err := h.applyAuthorFilter(c, q); err != nil {
+		return nil, err
+	}
+
 	if err := h.applyTextFilters(c, q); err != nil {
 		return nil, err
 	}
 
+	if err := h.applyStatusFilter(c, q); err != nil {
+		return nil, err
+	}
+
 	if err := h.applyPaginationAndOrder(c, q, builder); err != nil {
 		return nil, err
 	}
 
+	builder.BuildDeleted(c, &q.BaseQuery)
+
 	return q, nil
 }
 
@@ -77,6 +98,9 @@ func (h *ArticleHandler) applyCategoryFilter(c *gin.Context, q *articleQuery.Art
 			return errors.ErrInvalidIDFormat
 		}
 		q.WithCategoryID(uint(uid))
+		if c.Query("include_descendants") == "true" {
+			q.WithDescendants()
+		}
 	}
 	return nil
 }
@@ -96,6 +120,17 @@ func (h *ArticleHandler) applyTagFilters(c *gin.Context, q *articleQuery.Article
 	return nil
 }
 
+func (h *ArticleHandler) applyAuthorFilter(c *gin.Context, q *articleQuery.ArticleQuery) error {
+	if authorID := c.Query("author_id"); authorID != "" {
+		uid, err := strconv.ParseUint(authorID, 10, 32)
+		if err != nil {
+			return errors.ErrInvalidIDFormat
+		}
+		q.WithAuthorID(uint(uid))
+	}
+	return nil
+}
+
 func (h *ArticleHandler) applyTextFilters(c *gin.Context, q *articleQuery.ArticleQuery) error {
 	if title := c.Query("title"); title != "" {
 		if len(title) > constants.MaxNameLength {
@@ -113,6 +148,29 @@ func (h *ArticleHandler) applyTextFilters(c *gin.Context, q *articleQuery.Articl
 	return nil
 }
 
+// applyStatusFilter limits listings to published articles unless a status is requested.
+// "all" disables the filter. Asking for more than published articles is
+// authorized by ArticleService.AuthorizeQuery once the query is complete.
+func (h *ArticleHandler) applyStatusFilter(c *gin.Context, q *articleQuery.ArticleQuery) error {
+	status := c.Query("status")
+	if status == "" {
+		q.WithPublishedOnly()
+		return nil
+	}
+	if status == statusAll {
+		return nil
+	}
+
+	statuses := strings.Split(status, ",")
+	for _, s := range statuses {
+		if !articleEntity.ArticleStatus(s).IsValid() {
+			return errors.ErrInvalidStatus
+		}
+	}
+	q.WithStatuses(statuses...)
+	return nil
+}
+
 func (h *ArticleHandler) applyPaginationAndOrder(c *gin.Context, q *articleQuery.ArticleQuery, builder *sharedHttp.BaseQueryBuilder) error {
 	limit, offset, err := builder.BuildPagination(c, q.Limit, q.Offset)
 	if err != nil {
@@ -121,7 +179,8 @@ func (h *ArticleHandler) applyPaginationAndOrder(c *gin.Context, q *articleQuery
 	q.WithPagination(limit, offset)
 
 	orderBy, err := builder.BuildOrderBy(c, map[string]bool{
-		"title": true,
+		"title":        true,
+		"published_at": true,
 	})
 	if err != nil {
 		return err
@@ -129,7 +188,33 @@ func (h *ArticleHandler) applyPaginationAndOrder(c *gin.Context, q *articleQuery
 	if orderBy != "" {
 		q.WithOrderBy(orderBy)
 	}
-	return nil
+
+	return builder.BuildKeyset(c, &q.BaseQuery)
+}
+
+// Create handles POST / requests, recording the caller as the author.
+func (h *ArticleHandler) Create(c *gin.Context) {
+	if !h.Authorize(c, auth.ActionCreate, 0) {
+		return
+	}
+
+	var req dto.CreateArticleRequest
+	if !sharedHttp.BindJSON(c, &req) {
+		return
+	}
+
+	if principal, ok := middleware.PrincipalFrom(c); ok {
+		req.AuthorID = principal.UserID
+	}
+
+	article, err := h.articleService.Create(c.Request.Context(), &req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	h.Present(article)
+	response.Created(c, article)
 }
 
 func (h *ArticleHandler) FindAll(c *gin.Context) {
@@ -138,12 +223,110 @@ func (h *ArticleHandler) FindAll(c *gin.Context) {
 
 func (h *ArticleHandler) FindByID(c *gin.Context) {
 	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionRead, id) {
+		return
+	}
+
 	query := articleQuery.NewArticleQuery()
 	preloadAssociations := query.GetPreloadAssociations()
-	entity, err := h.Service.FindByID(id, preloadAssociations...)
+	entity, err := h.Service.FindByID(c.Request.Context(), id, preloadAssociations...)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	h.Present(entity)
+	sharedHttp.RespondEntity(c, entity)
+}
+
+// FindBySlug handles GET /slug/:slug requests. A slug the article was
+// renamed away from answers with a permanent redirect to the current one.
+func (h *ArticleHandler) FindBySlug(c *gin.Context) {
+	value := c.Param("slug")
+	query := articleQuery.NewArticleQuery()
+	article, err := h.articleService.FindBySlug(c.Request.Context(), value, query.GetPreloadAssociations()...)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	principal, _ := middleware.PrincipalFrom(c)
+	if err := h.articleService.AuthorizeRead(principal, article); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	if article.Slug != value {
+		location := url.URL{
+			Path:     path.Join(path.Dir(c.Request.URL.Path), article.Slug),
+			RawQuery: c.Request.URL.RawQuery,
+		}
+		c.Redirect(http.StatusMovedPermanently, location.String())
+		return
+	}
+	h.Present(article)
+	sharedHttp.RespondEntity(c, article)
+}
+
+// FindByAuthor handles GET /authors/:id/articles requests. It accepts the
+// same filters as FindAll, scoped to the author in the path.
+func (h *ArticleHandler) FindByAuthor(c *gin.Context) {
+	authorID := sharedHttp.ParseUintParam(c, "id")
+	h.BaseHandler.FindAll(c, func(c *gin.Context) (*articleQuery.ArticleQuery, error) {
+		q, err := h.buildQuery(c)
+		if err != nil {
+			return nil, err
+		}
+		return q.WithAuthorID(authorID), nil
+	})
+}
+
+// Publish handles POST /:id/publish requests.
+func (h *ArticleHandler) Publish(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionPublish, id) {
+		return
+	}
+	article, err := h.articleService.Publish(c.Request.Context(), id)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	h.Present(article)
+	response.Success(c, article)
+}
+
+// Unpublish handles POST /:id/unpublish requests.
+func (h *ArticleHandler) Unpublish(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionPublish, id) {
+		return
+	}
+	article, err := h.articleService.Unpublish(c.Request.Context(), id)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	h.Present(article)
+	response.Success(c, article)
+}
+
+// Schedule handles POST /:id/schedule requests.
+func (h *ArticleHandler) Schedule(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionPublish, id) {
+		return
+	}
+
+	var req dto.ScheduleArticleRequest
+	if !sharedHttp.BindJSON(c, &req) {
+		return
+	}
+
+	article, err := h.articleService.Schedule(c.Request.Context(), id, &req)
 	if err != nil {
-		response.NotFound(c)
+		response.HandleError(c, err)
 		return
 	}
-	response.Success(c, entity)
+	h.Present(article)
+	response.Success(c, article)
 }
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	sharedHttp "github.com/jambo0624/blog/internal/shared/interfaces/http"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+)
+
+// The revision history is editorial: reading it takes the same permission as
+// changing the article, whatever its status.
+
+// FindRevisions handles GET /:id/revisions requests.
+func (h *ArticleHandler) FindRevisions(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionUpdate, id) {
+		return
+	}
+	revisions, err := h.articleService.FindRevisions(c.Request.Context(), id)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.Success(c, revisions)
+}
+
+// FindRevision handles GET /:id/revisions/:revision requests.
+func (h *ArticleHandler) FindRevision(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionUpdate, id) {
+		return
+	}
+	number := sharedHttp.ParseUintParam(c, "revision")
+	revision, err := h.articleService.FindRevision(c.Request.Context(), id, number)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.Success(c, revision)
+}
+
+// DiffRevisions handles GET /:id/revisions/diff?from=&to= requests.
+func (h *ArticleHandler) DiffRevisions(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionUpdate, id) {
+		return
+	}
+
+	from, err := parseRevisionQuery(c, "from")
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+	to, err := parseRevisionQuery(c, "to")
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := h.articleService.DiffRevisions(c.Request.Context(), id, from, to)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+// RestoreRevision handles POST /:id/revisions/:revision/restore requests.
+func (h *ArticleHandler) RestoreRevision(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionUpdate, id) {
+		return
+	}
+
+	number := sharedHttp.ParseUintParam(c, "revision")
+	article, err := h.articleService.RestoreRevision(c.Request.Context(), id, number)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	h.Present(article)
+	response.Success(c, article)
+}
+
+func parseRevisionQuery(c *gin.Context, key string) (uint, error) {
+	value := c.Query(key)
+	if value == "" {
+		return 0, errors.ErrRevisionRequired
+	}
+	number, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || number == 0 {
+		return 0, errors.ErrInvalidIDFormat
+	}
+	return uint(number), nil
+}
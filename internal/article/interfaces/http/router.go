@@ -17,8 +17,53 @@ func (r *ArticleRouter) Register(api *gin.RouterGroup) {
 	{
 		articles.POST("", r.handler.Create)
 		articles.GET("", r.handler.FindAll)
+		articles.GET("/search", r.handler.Search)
+		articles.GET("/slug/:slug", r.handler.FindBySlug)
 		articles.GET("/:id", r.handler.FindByID)
 		articles.PUT("/:id", r.handler.Update)
 		articles.DELETE("/:id", r.handler.Delete)
+		articles.POST("/:id/restore", r.handler.Restore)
+		articles.DELETE("/:id/purge", r.handler.Purge)
+		articles.POST("/:id/publish", r.handler.Publish)
+		articles.POST("/:id/unpublish", r.handler.Unpublish)
+		articles.POST("/:id/schedule", r.handler.Schedule)
+		articles.GET("/:id/revisions", r.handler.FindRevisions)
+		articles.GET("/:id/revisions/diff", r.handler.DiffRevisions)
+		articles.GET("/:id/revisions/:revision", r.handler.FindRevision)
+		articles.POST("/:id/revisions/:revision/restore", r.handler.RestoreRevision)
+	}
+
+	authors := api.Group("/authors")
+	{
+		authors.GET("/:id/articles", r.handler.FindByAuthor)
+	}
+}
+
+type FeedRouter struct {
+	handler *FeedHandler
+}
+
+func NewFeedRouter(handler *FeedHandler) *FeedRouter {
+	return &FeedRouter{handler: handler}
+}
+
+// Register adds the feeds at the site root, outside the JSON API.
+func (r *FeedRouter) Register(root *gin.RouterGroup) {
+	scopes := []struct {
+		prefix  string
+		handler func(feedFormat) gin.HandlerFunc
+	}{
+		{"", r.handler.Site},
+		{"/categories/:slug", r.handler.Category},
+		{"/tags/:id", r.handler.Tag},
+	}
+
+	for _, scope := range scopes {
+		group := root.Group(scope.prefix)
+		{
+			group.GET("/feed.xml", scope.handler(formatRSS))
+			group.GET("/atom.xml", scope.handler(formatAtom))
+			group.GET("/feed.json", scope.handler(formatJSON))
+		}
 	}
 }
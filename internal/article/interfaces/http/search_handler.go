@@ -0,0 +1,55 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	articleQuery "github.com/jambo0624/blog/internal/article/domain/query"
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+)
+
+// Search handles GET /search?q= requests. It accepts the same filters and
+// pagination as the listing endpoint, ordered by relevance.
+func (h *ArticleHandler) Search(c *gin.Context) {
+	q, err := h.buildQuery(c)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+	if !h.AuthorizeQuery(c, q) {
+		return
+	}
+
+	term := c.Query("q")
+	tsQuery, err := parseSearchTerm(term)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+	q.WithSearch(tsQuery)
+
+	results, total, err := h.articleService.Search(c.Request.Context(), q)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	for _, result := range results {
+		h.Present(result.Article)
+	}
+
+	meta := response.NewMeta(int(total), q.Limit, q.Offset).
+		WithSort("rank", "desc").
+		WithFilter(map[string]string{"q": term})
+	response.SuccessWithMeta(c, results, *meta)
+}
+
+func parseSearchTerm(term string) (string, error) {
+	if term == "" {
+		return "", errors.ErrSearchQueryRequired
+	}
+	if len(term) > constants.MaxTitleLength {
+		return "", errors.ErrSearchQueryTooLong
+	}
+	return articleQuery.ToTSQuery(term)
+}
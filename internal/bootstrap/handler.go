@@ -3,19 +3,43 @@ package bootstrap
 import (
 	articleHttp "github.com/jambo0624/blog/internal/article/interfaces/http"
 	categoryHttp "github.com/jambo0624/blog/internal/category/interfaces/http"
+	commentHttp "github.com/jambo0624/blog/internal/comment/interfaces/http"
+	seoHttp "github.com/jambo0624/blog/internal/seo/interfaces/http"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/config"
 	tagHttp "github.com/jambo0624/blog/internal/tag/interfaces/http"
+	userHttp "github.com/jambo0624/blog/internal/user/interfaces/http"
 )
 
 type Handlers struct {
 	Article  *articleHttp.ArticleHandler
 	Category *categoryHttp.CategoryHandler
 	Tag      *tagHttp.TagHandler
+	User     *userHttp.UserHandler
+	Auth     *userHttp.AuthHandler
+	Comment  *commentHttp.CommentHandler
+	Feed     *articleHttp.FeedHandler
+	SEO      *seoHttp.SEOHandler
 }
 
-func SetupHandlers(services *Services) *Handlers {
+func SetupHandlers(cfg *config.Config, services *Services) *Handlers {
 	return &Handlers{
 		Article:  articleHttp.NewArticleHandler(services.Article),
 		Category: categoryHttp.NewCategoryHandler(services.Category),
 		Tag:      tagHttp.NewTagHandler(services.Tag),
+		User:     userHttp.NewUserHandler(services.User),
+		Auth:     userHttp.NewAuthHandler(services.Auth),
+		Comment:  commentHttp.NewCommentHandler(services.Comment),
+		Feed: articleHttp.NewFeedHandler(services.Article, services.Category, services.Tag, articleHttp.FeedOptions{
+			SiteURL:     cfg.Site.URL,
+			Title:       cfg.Site.Title,
+			Description: cfg.Site.Description,
+			Limit:       cfg.Feed.Limit,
+			Content:     cfg.Feed.Content,
+		}),
+		SEO: seoHttp.NewSEOHandler(services.Article, services.Category, services.Tag, seoHttp.SEOOptions{
+			SiteURL:        cfg.Site.URL,
+			RobotsAllow:    cfg.Robots.Allow,
+			RobotsDisallow: cfg.Robots.Disallow,
+		}),
 	}
 }
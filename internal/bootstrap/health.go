@@ -0,0 +1,190 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/shared/infrastructure/errors"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
+)
+
+// healthCheckTimeout bounds each readiness check, so one hanging dependency
+// cannot hold the probe past its own timeout.
+const healthCheckTimeout = 2 * time.Second
+
+// Health statuses.
+const (
+	HealthOK           = "ok"
+	HealthFailing      = "failing"
+	HealthShuttingDown = "shutting_down"
+)
+
+// HealthCheck reports whether a dependency can serve requests.
+type HealthCheck func(ctx context.Context) error
+
+// CheckResult is the outcome of one readiness check.
+type CheckResult struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	DurationMS int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// HealthReport is the body of the health endpoints.
+type HealthReport struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks,omitempty"`
+}
+
+type namedCheck struct {
+	name  string
+	check HealthCheck
+}
+
+// Health serves the liveness and readiness probes. The instance is ready
+// while every registered check passes and it is not shutting down.
+type Health struct {
+	mu           sync.RWMutex
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+}
+
+func NewHealth() *Health {
+	return &Health{}
+}
+
+// SetupHealth registers the readiness checks of the application: the
+// database answers, its schema is at the latest migration and every
+// repository, service and handler has been wired.
+func SetupHealth(db *gorm.DB, repos *Repositories, services *Services, handlers *Handlers) *Health {
+	health := NewHealth()
+	health.AddCheck("database", func(ctx context.Context) error {
+		return persistence.PingDB(ctx, db)
+	})
+	health.AddCheck("migrations", func(ctx context.Context) error {
+		return persistence.CheckMigrations(ctx, db)
+	})
+	health.AddCheck("dependencies", registered(repos, services, handlers))
+	return health
+}
+
+// AddCheck registers a readiness check. Checks run in registration order.
+func (h *Health) AddCheck(name string, check HealthCheck) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checks = append(h.checks, namedCheck{name: name, check: check})
+}
+
+// MarkShuttingDown makes readiness fail from now on, so load balancers stop
+// routing new requests while the in-flight ones drain.
+func (h *Health) MarkShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// Ready runs every check and reports their outcome. The checks are skipped
+// once the instance is shutting down.
+func (h *Health) Ready(ctx context.Context) HealthReport {
+	if h.shuttingDown.Load() {
+		return HealthReport{Status: HealthShuttingDown}
+	}
+
+	h.mu.RLock()
+	checks := h.checks
+	h.mu.RUnlock()
+
+	report := HealthReport{Status: HealthOK, Checks: make([]CheckResult, 0, len(checks))}
+	for _, c := range checks {
+		result := runCheck(ctx, c)
+		if result.Status != HealthOK {
+			report.Status = HealthFailing
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	return report
+}
+
+func runCheck(ctx context.Context, c namedCheck) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := c.check(ctx)
+	result := CheckResult{
+		Name:       c.name,
+		Status:     HealthOK,
+		DurationMS: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = HealthFailing
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Register mounts /healthz and /readyz on r.
+func (h *Health) Register(r *gin.RouterGroup) {
+	r.GET("/healthz", h.live)
+	r.GET("/readyz", h.ready)
+}
+
+// live answers as long as the process can serve HTTP at all; it checks no
+// dependency, so a database outage does not get every replica restarted.
+func (h *Health) live(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, HealthReport{Status: HealthOK})
+}
+
+func (h *Health) ready(c *gin.Context) {
+	report := h.Ready(c.Request.Context())
+
+	status := http.StatusOK
+	if report.Status != HealthOK {
+		status = http.StatusServiceUnavailable
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(status, report)
+}
+
+// registered checks that no field of the given wiring structs, such as
+// Repositories or Services, was left nil.
+func registered(sets ...any) HealthCheck {
+	return func(context.Context) error {
+		var missing []string
+		for _, set := range sets {
+			value := reflect.ValueOf(set)
+			if value.Kind() == reflect.Pointer && value.IsNil() {
+				missing = append(missing, value.Type().Elem().Name())
+				continue
+			}
+			value = reflect.Indirect(value)
+			for i := range value.NumField() {
+				if isNil(value.Field(i)) {
+					missing = append(missing, value.Type().Name()+"."+value.Type().Field(i).Name)
+				}
+			}
+		}
+
+		if len(missing) > 0 {
+			return fmt.Errorf("%w: %s", errors.ErrMissingDependency, strings.Join(missing, ", "))
+		}
+		return nil
+	}
+}
+
+func isNil(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return value.IsNil()
+	default:
+		return false
+	}
+}
@@ -7,20 +7,38 @@ import (
 	articlePersistence "github.com/jambo0624/blog/internal/article/infrastructure/repository"
 	categoryRepository "github.com/jambo0624/blog/internal/category/domain/repository"
 	categoryPersistence "github.com/jambo0624/blog/internal/category/infrastructure/repository"
+	commentRepository "github.com/jambo0624/blog/internal/comment/domain/repository"
+	commentPersistence "github.com/jambo0624/blog/internal/comment/infrastructure/repository"
+	sharedRepository "github.com/jambo0624/blog/internal/shared/domain/repository"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
 	tagRepository "github.com/jambo0624/blog/internal/tag/domain/repository"
 	tagPersistence "github.com/jambo0624/blog/internal/tag/infrastructure/repository"
+	userRepository "github.com/jambo0624/blog/internal/user/domain/repository"
+	userPersistence "github.com/jambo0624/blog/internal/user/infrastructure/repository"
 )
 
 type Repositories struct {
-	Article  articleRepository.ArticleRepository
-	Category categoryRepository.CategoryRepository
-	Tag      tagRepository.TagRepository
+	Article             articleRepository.ArticleRepository
+	ArticleRevision     articleRepository.ArticleRevisionRepository
+	ScheduledPublishRun articleRepository.ScheduledPublishRunRepository
+	Category            categoryRepository.CategoryRepository
+	Tag                 tagRepository.TagRepository
+	User                userRepository.UserRepository
+	APIKey              userRepository.APIKeyRepository
+	Comment             commentRepository.CommentRepository
+	UnitOfWork          sharedRepository.UnitOfWork
 }
 
 func SetupRepositories(db *gorm.DB) *Repositories {
 	return &Repositories{
-		Article:  articlePersistence.NewGormArticleRepository(db),
-		Category: categoryPersistence.NewGormCategoryRepository(db),
-		Tag:      tagPersistence.NewGormTagRepository(db),
+		Article:             articlePersistence.NewGormArticleRepository(db),
+		ArticleRevision:     articlePersistence.NewGormArticleRevisionRepository(db),
+		ScheduledPublishRun: articlePersistence.NewGormScheduledPublishRunRepository(db),
+		Category:            categoryPersistence.NewGormCategoryRepository(db),
+		Tag:                 tagPersistence.NewGormTagRepository(db),
+		User:                userPersistence.NewGormUserRepository(db),
+		APIKey:              userPersistence.NewGormAPIKeyRepository(db),
+		Comment:             commentPersistence.NewGormCommentRepository(db),
+		UnitOfWork:          persistence.NewGormUnitOfWork(db),
 	}
 }
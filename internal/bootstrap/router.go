@@ -5,29 +5,71 @@ import (
 
 	articleHttp "github.com/jambo0624/blog/internal/article/interfaces/http"
 	categoryHttp "github.com/jambo0624/blog/internal/category/interfaces/http"
+	commentHttp "github.com/jambo0624/blog/internal/comment/interfaces/http"
+	seoHttp "github.com/jambo0624/blog/internal/seo/interfaces/http"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/config"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
 	tagHttp "github.com/jambo0624/blog/internal/tag/interfaces/http"
+	userHttp "github.com/jambo0624/blog/internal/user/interfaces/http"
 )
 
 type Router interface {
 	Register(r *gin.RouterGroup)
 }
 
-func SetupRouter(handlers *Handlers) *gin.Engine {
+func SetupRouter(cfg *config.Config, handlers *Handlers, health *Health, authenticator middleware.Authenticator) *gin.Engine {
 	r := gin.Default()
 
-	api := r.Group("/api")
+	// render errors in the configured format unless the client asks otherwise
+	r.Use(middleware.ErrorFormat(response.ErrorFormat{
+		Default:     cfg.Errors.Format,
+		TypeBaseURL: cfg.Errors.ProblemTypeBase,
+	}))
+
+	// probes sit at the site root, outside auth and caching
+	health.Register(&r.RouterGroup)
+
+	// content routes are public to read, writes need credentials, and reads
+	// carry the configured Cache-Control policy
+	content := r.Group("/api",
+		middleware.Timeout(cfg.Server.RequestTimeoutFor("api")),
+		middleware.RequireAuthForWrites(authenticator),
+		middleware.CacheControl(cfg.Cache.Default, cfg.Cache.Policies),
+	)
 
 	// register all router groups
 	routers := []Router{
 		articleHttp.NewArticleRouter(handlers.Article),
 		categoryHttp.NewCategoryRouter(handlers.Category),
 		tagHttp.NewTagRouter(handlers.Tag),
+		commentHttp.NewCommentRouter(handlers.Comment, authenticator),
 	}
 
 	// register all router groups
 	for _, r := range routers {
-		r.Register(api)
+		r.Register(content)
 	}
 
+	// feeds live at the site root and are always public
+	feeds := r.Group("",
+		middleware.Timeout(cfg.Server.RequestTimeoutFor("feeds")),
+		middleware.CacheControl(cfg.Cache.Policy("feeds"), nil),
+	)
+	articleHttp.NewFeedRouter(handlers.Feed).Register(feeds)
+
+	// robots.txt and the sitemaps sit next to the feeds for crawlers, and
+	// may take longer to build than an API response
+	seo := r.Group("",
+		middleware.Timeout(cfg.Server.RequestTimeoutFor("seo")),
+		middleware.CacheControl(cfg.Cache.Policy("seo"), nil),
+	)
+	seoHttp.NewSEORouter(handlers.SEO).Register(seo)
+
+	// account routes apply their own auth rules
+	api := r.Group("/api", middleware.Timeout(cfg.Server.RequestTimeoutFor("api")))
+	userHttp.NewAuthRouter(handlers.Auth, authenticator).Register(api)
+	userHttp.NewUserRouter(handlers.User, authenticator).Register(api)
+
 	return r
 }
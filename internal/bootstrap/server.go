@@ -0,0 +1,117 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/jambo0624/blog/internal/shared/infrastructure/config"
+)
+
+// ShutdownHook releases a resource once the server has stopped serving.
+type ShutdownHook func(ctx context.Context) error
+
+type shutdownStep struct {
+	name string
+	hook ShutdownHook
+}
+
+// Server serves HTTP until its context is cancelled, then drains in-flight
+// requests and runs the shutdown hooks.
+type Server struct {
+	http   *http.Server
+	cfg    config.ServerConfig
+	hooks  []shutdownStep
+	health *Health
+}
+
+func NewServer(cfg config.ServerConfig, handler http.Handler) *Server {
+	return &Server{
+		http: &http.Server{
+			Addr:              ":" + cfg.Port,
+			Handler:           handler,
+			ReadTimeout:       cfg.ReadTimeout,
+			ReadHeaderTimeout: cfg.ReadTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			IdleTimeout:       cfg.IdleTimeout,
+		},
+		cfg: cfg,
+	}
+}
+
+// WithHealth marks health as shutting down as soon as shutdown begins, and
+// keeps serving for the configured delay so load balancers see the instance
+// go unready before it stops accepting connections.
+func (s *Server) WithHealth(health *Health) *Server {
+	s.health = health
+	return s
+}
+
+// OnShutdown registers hook to run after the requests have drained. Hooks
+// run in the order they were registered, so register what depends on a
+// resource before the resource itself, e.g. workers before the database.
+func (s *Server) OnShutdown(name string, hook ShutdownHook) {
+	s.hooks = append(s.hooks, shutdownStep{name: name, hook: hook})
+}
+
+// Run listens on the configured port until ctx is cancelled.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.http.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	return s.Serve(ctx, listener)
+}
+
+// Serve accepts connections on listener until ctx is cancelled, then reports
+// not ready for the shutdown delay, stops accepting, waits up to the shutdown
+// timeout for in-flight requests and runs the shutdown hooks within the same
+// deadline. Every hook runs even when an earlier step failed; the failures
+// are joined.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.http.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		// the server failed on its own, release the resources all the same
+		return errors.Join(fmt.Errorf("failed to serve: %w", err), s.runHooks(context.Background()))
+	case <-ctx.Done():
+	}
+
+	log.Println("Shutting down server...")
+	if s.health != nil {
+		s.health.MarkShuttingDown()
+		time.Sleep(s.cfg.ShutdownDelay)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	if err := s.http.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to drain requests: %w", err))
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		errs = append(errs, fmt.Errorf("failed to serve: %w", err))
+	}
+	errs = append(errs, s.runHooks(shutdownCtx))
+
+	return errors.Join(errs...)
+}
+
+func (s *Server) runHooks(ctx context.Context) error {
+	var errs []error
+	for _, step := range s.hooks {
+		if err := step.hook(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", step.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
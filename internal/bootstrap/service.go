@@ -3,19 +3,35 @@ package bootstrap
 import (
 	articleService "github.com/jambo0624/blog/internal/article/application/service"
 	categoryService "github.com/jambo0624/blog/internal/category/application/service"
+	commentService "github.com/jambo0624/blog/internal/comment/application/service"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/config"
 	tagService "github.com/jambo0624/blog/internal/tag/application/service"
+	userService "github.com/jambo0624/blog/internal/user/application/service"
+	"github.com/jambo0624/blog/internal/user/infrastructure/token"
 )
 
 type Services struct {
 	Article  *articleService.ArticleService
 	Category *categoryService.CategoryService
 	Tag      *tagService.TagService
+	User     *userService.UserService
+	Auth     *userService.AuthService
+	Comment  *commentService.CommentService
 }
 
-func SetupServices(repos *Repositories) *Services {
+func SetupServices(cfg *config.Config, repos *Repositories) *Services {
 	return &Services{
-		Article:  articleService.NewArticleService(repos.Article, repos.Category, repos.Tag),
+		Article: articleService.NewArticleService(repos.Article, repos.Category, repos.Tag, repos.ArticleRevision).
+			WithUnitOfWork(repos.UnitOfWork),
 		Category: categoryService.NewCategoryService(repos.Category),
 		Tag:      tagService.NewTagService(repos.Tag),
+		User:     userService.NewUserService(repos.User),
+		Auth: userService.NewAuthService(
+			repos.User,
+			repos.APIKey,
+			token.NewJWTIssuer(cfg.Auth.JWTSecret, cfg.Auth.TokenTTL),
+		),
+		Comment: commentService.NewCommentService(repos.Comment, repos.Article).
+			WithUnitOfWork(repos.UnitOfWork),
 	}
 }
@@ -0,0 +1,73 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm"
+
+	articleScheduler "github.com/jambo0624/blog/internal/article/application/scheduler"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/config"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
+)
+
+// Worker is a background process that runs until its context is cancelled.
+type Worker interface {
+	Start(ctx context.Context)
+}
+
+func SetupWorkers(cfg *config.Config, db *gorm.DB, repos *Repositories, services *Services) []Worker {
+	workers := []Worker{}
+
+	if cfg.Scheduler.Enabled {
+		workers = append(workers, articleScheduler.NewScheduledPublisher(
+			services.Article,
+			repos.ScheduledPublishRun,
+			persistence.NewAdvisoryLocker(db),
+			cfg.Scheduler.Interval,
+		))
+	}
+
+	return workers
+}
+
+// WorkerGroup tracks running workers so shutdown can wait for them.
+type WorkerGroup struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// StartWorkers launches each worker in its own goroutine, with a context
+// that is cancelled by Stop or when ctx is.
+func StartWorkers(ctx context.Context, workers []Worker) *WorkerGroup {
+	ctx, cancel := context.WithCancel(ctx)
+	group := &WorkerGroup{cancel: cancel}
+	for _, w := range workers {
+		group.wg.Add(1)
+		go func() {
+			defer group.wg.Done()
+			w.Start(ctx)
+		}()
+	}
+	return group
+}
+
+// Stop cancels the workers and waits for them to return, giving up when ctx
+// is done first.
+func (g *WorkerGroup) Stop(ctx context.Context) error {
+	g.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to stop workers: %w", ctx.Err())
+	}
+}
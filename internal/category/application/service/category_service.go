@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/getsentry/sentry-go"
@@ -9,28 +10,37 @@ import (
 	"github.com/jambo0624/blog/internal/category/domain/query"
 	"github.com/jambo0624/blog/internal/category/interfaces/http/dto"
 	"github.com/jambo0624/blog/internal/shared/application/service"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
 	"github.com/jambo0624/blog/internal/shared/domain/repository"
 )
 
 type CategoryService struct {
 	*service.BaseService[entity.Category, *query.CategoryQuery]
+	*service.ResourceGuard
 }
 
 func NewCategoryService(repo repository.BaseRepository[entity.Category, *query.CategoryQuery]) *CategoryService {
 	baseService := service.NewBaseService(repo)
 	return &CategoryService{
-		BaseService: baseService,
+		BaseService:   baseService,
+		ResourceGuard: service.NewResourceGuard(auth.ResourceCategory),
 	}
 }
 
-func (s *CategoryService) Create(req *dto.CreateCategoryRequest) (*entity.Category, error) {
+func (s *CategoryService) Create(ctx context.Context, req *dto.CreateCategoryRequest) (*entity.Category, error) {
 	category, err := entity.NewCategory(req.Name, req.Slug)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil, fmt.Errorf("failed to create category: %w", err)
 	}
 
-	if err := s.Repo.Save(category); err != nil {
+	if req.ParentID != nil && *req.ParentID != 0 {
+		if err := s.moveTo(ctx, category, *req.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.Repo.Save(ctx, category); err != nil {
 		sentry.CaptureException(err)
 		return nil, fmt.Errorf("failed to save category: %w", err)
 	}
@@ -38,16 +48,26 @@ func (s *CategoryService) Create(req *dto.CreateCategoryRequest) (*entity.Catego
 	return category, nil
 }
 
-func (s *CategoryService) Update(id uint, req *dto.UpdateCategoryRequest) (*entity.Category, error) {
-	category, err := s.FindByID(id)
+func (s *CategoryService) Update(ctx context.Context, id uint, req *dto.UpdateCategoryRequest) (*entity.Category, error) {
+	category, err := s.FindByID(ctx, id)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil, fmt.Errorf("failed to find category by id: %w", err)
 	}
 
+	if err := req.CheckVersion(category.Version); err != nil {
+		return nil, err
+	}
+
 	category.Update(req)
 
-	if err := s.Repo.Update(category); err != nil {
+	if req.ParentID != nil {
+		if err := s.moveTo(ctx, category, *req.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.Repo.Update(ctx, category); err != nil {
 		sentry.CaptureException(err)
 		return nil, fmt.Errorf("failed to update category: %w", err)
 	}
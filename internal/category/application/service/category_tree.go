@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/jambo0624/blog/internal/category/domain/entity"
+	"github.com/jambo0624/blog/internal/category/domain/query"
+	"github.com/jambo0624/blog/internal/category/domain/tree"
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+// Tree returns all categories nested under their parents.
+func (s *CategoryService) Tree(ctx context.Context) ([]*entity.Category, error) {
+	t, err := s.loadTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return t.Roots(), nil
+}
+
+// Breadcrumb returns the path from the root category down to id.
+func (s *CategoryService) Breadcrumb(ctx context.Context, id uint) ([]*entity.Category, error) {
+	t, err := s.loadTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := t.Breadcrumb(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build breadcrumb: %w", err)
+	}
+	return path, nil
+}
+
+// moveTo places category under parentID, or at the root when parentID is 0.
+func (s *CategoryService) moveTo(ctx context.Context, category *entity.Category, parentID uint) error {
+	if parentID == 0 {
+		return category.SetParent(nil, nil)
+	}
+
+	parent, err := s.FindByID(ctx, parentID)
+	if errors.Is(err, domainErrors.ErrNotFound) {
+		return domainErrors.ErrUnknownCategory
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		return fmt.Errorf("failed to find parent category: %w", err)
+	}
+
+	t, err := s.loadTree(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := category.SetParent(parent, t.AncestorIDs(parent.ID)); err != nil {
+		return fmt.Errorf("failed to set parent category: %w", err)
+	}
+	return nil
+}
+
+// loadTree loads every category; the set is small enough to walk in memory.
+func (s *CategoryService) loadTree(ctx context.Context) (*tree.Tree, error) {
+	q := query.NewCategoryQuery()
+	q.WithPagination(0, 0)
+
+	categories, _, err := s.FindAll(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load categories: %w", err)
+	}
+	return tree.New(categories), nil
+}
@@ -3,17 +3,22 @@ package entity
 import (
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/jambo0624/blog/internal/category/interfaces/http/dto"
 	"github.com/jambo0624/blog/internal/shared/domain/errors"
 )
 
 type Category struct {
-	ID        uint       `binding:"required"               gorm:"primary_key"              json:"id"`
-	Name      string     `binding:"required"               gorm:"size:100;not null"        json:"name"`
-	Slug      string     `binding:"required"               gorm:"size:100;not null;unique" json:"slug"`
-	CreatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
-	UpdatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
-	DeletedAt *time.Time `gorm:"index"                     json:"deletedAt"`
+	ID        uint           `binding:"required"               gorm:"primary_key"              json:"id"`
+	ParentID  *uint          `gorm:"index"                     json:"parentId"`
+	Children  []*Category    `gorm:"foreignKey:ParentID"       json:"children,omitempty"`
+	Name      string         `binding:"required"               gorm:"size:100;not null"        json:"name"`
+	Slug      string         `binding:"required"               gorm:"size:100;not null;unique" json:"slug"`
+	Version   uint           `gorm:"not null;default:1"        json:"version"`
+	CreatedAt time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
+	DeletedAt gorm.DeletedAt `gorm:"index"                     json:"deletedAt"`
 }
 
 // NewCategory create new category, all fields are required.
@@ -44,7 +49,57 @@ func (c *Category) Update(req *dto.UpdateCategoryRequest) {
 	c.UpdatedAt = time.Now()
 }
 
+// SetParent moves the category under parent, or to the root when parent is
+// nil. parentAncestorIDs are the IDs above parent, used to reject cycles.
+func (c *Category) SetParent(parent *Category, parentAncestorIDs []uint) error {
+	if parent == nil {
+		c.ParentID = nil
+		return nil
+	}
+
+	if c.ID != 0 {
+		if parent.ID == c.ID {
+			return errors.ErrCategoryCycle
+		}
+		for _, id := range parentAncestorIDs {
+			if id == c.ID {
+				return errors.ErrCategoryCycle
+			}
+		}
+	}
+
+	parentID := parent.ID
+	c.ParentID = &parentID
+	return nil
+}
+
+// IsRoot reports whether the category has no parent.
+func (c *Category) IsRoot() bool {
+	return c.ParentID == nil
+}
+
 // GetID get category id, implement Entity interface.
 func (c Category) GetID() uint {
 	return c.ID
 }
+
+// GetUpdatedAt get category last modification time, implement Timestamped interface.
+func (c Category) GetUpdatedAt() time.Time {
+	return c.UpdatedAt
+}
+
+// EmbedsDerivedData reports that the category carries its children,
+// implement Composite interface.
+func (Category) EmbedsDerivedData() bool {
+	return true
+}
+
+// GetVersion get category version, implement Versioned interface.
+func (c Category) GetVersion() uint {
+	return c.Version
+}
+
+// SetVersion set category version, implement Versioned interface.
+func (c *Category) SetVersion(version uint) {
+	c.Version = version
+}
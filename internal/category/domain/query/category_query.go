@@ -10,6 +10,7 @@ type CategoryQuery struct {
 	baseQuery.BaseQuery
 	NameLike string `binding:"omitempty, max=100" json:"nameLike" validate:"omitempty,max=100"`
 	SlugLike string `binding:"omitempty, max=100" json:"slugLike" validate:"omitempty,max=100"`
+	Slug     string `binding:"omitempty, max=100" json:"slug"     validate:"omitempty,max=100"`
 }
 
 func NewCategoryQuery() *CategoryQuery {
@@ -28,6 +29,12 @@ func (q *CategoryQuery) WithSlugLike(slug string) *CategoryQuery {
 	return q
 }
 
+// WithSlug matches the category whose slug is exactly slug.
+func (q *CategoryQuery) WithSlug(slug string) *CategoryQuery {
+	q.Slug = slug
+	return q
+}
+
 func (q *CategoryQuery) Validate() error {
 	return q.BaseQuery.ValidateQuery(q)
 }
@@ -46,5 +53,8 @@ func (q *CategoryQuery) ApplyFilters(db *gorm.DB) *gorm.DB {
 	if q.SlugLike != "" {
 		db = db.Where("slug LIKE ?", "%"+q.SlugLike+"%")
 	}
+	if q.Slug != "" {
+		db = db.Where("slug = ?", q.Slug)
+	}
 	return db
 }
@@ -0,0 +1,90 @@
+package tree
+
+import (
+	"github.com/jambo0624/blog/internal/category/domain/entity"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+// Tree indexes a flat list of categories by ID and parent.
+type Tree struct {
+	byID     map[uint]*entity.Category
+	children map[uint][]*entity.Category
+	roots    []*entity.Category
+}
+
+// New builds a tree from a flat list of categories. Categories whose parent
+// is missing from the list are treated as roots.
+func New(categories []*entity.Category) *Tree {
+	t := &Tree{
+		byID:     make(map[uint]*entity.Category, len(categories)),
+		children: make(map[uint][]*entity.Category),
+	}
+	for _, c := range categories {
+		t.byID[c.ID] = c
+	}
+	for _, c := range categories {
+		if c.ParentID != nil {
+			if _, ok := t.byID[*c.ParentID]; ok {
+				t.children[*c.ParentID] = append(t.children[*c.ParentID], c)
+				continue
+			}
+		}
+		t.roots = append(t.roots, c)
+	}
+	return t
+}
+
+// Roots returns the top level categories with Children populated recursively.
+func (t *Tree) Roots() []*entity.Category {
+	for _, root := range t.roots {
+		t.attachChildren(root, map[uint]bool{})
+	}
+	if t.roots == nil {
+		return []*entity.Category{}
+	}
+	return t.roots
+}
+
+// Breadcrumb returns the path from the root down to the category.
+func (t *Tree) Breadcrumb(id uint) ([]*entity.Category, error) {
+	current, ok := t.byID[id]
+	if !ok {
+		return nil, errors.ErrCategoryNotFound
+	}
+
+	var path []*entity.Category
+	visited := map[uint]bool{}
+	for current != nil && !visited[current.ID] {
+		visited[current.ID] = true
+		path = append([]*entity.Category{current}, path...)
+		if current.ParentID == nil {
+			break
+		}
+		current = t.byID[*current.ParentID]
+	}
+	return path, nil
+}
+
+// AncestorIDs returns the IDs above the category, nearest first.
+func (t *Tree) AncestorIDs(id uint) []uint {
+	path, err := t.Breadcrumb(id)
+	if err != nil {
+		return nil
+	}
+	ids := make([]uint, 0, len(path))
+	for i := len(path) - 2; i >= 0; i-- {
+		ids = append(ids, path[i].ID)
+	}
+	return ids
+}
+
+func (t *Tree) attachChildren(c *entity.Category, visited map[uint]bool) {
+	if visited[c.ID] {
+		return
+	}
+	visited[c.ID] = true
+	c.Children = t.children[c.ID]
+	for _, child := range c.Children {
+		t.attachChildren(child, visited)
+	}
+}
@@ -1,11 +1,15 @@
 package persistence
 
 import (
+	"context"
+
 	"gorm.io/gorm"
 
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
 	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
 	categoryQuery "github.com/jambo0624/blog/internal/category/domain/query"
 	categoryRepository "github.com/jambo0624/blog/internal/category/domain/repository"
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
 	persistence "github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
 )
 
@@ -18,3 +22,23 @@ func NewGormCategoryRepository(db *gorm.DB) categoryRepository.CategoryRepositor
 		BaseGormRepository: persistence.NewBaseGormRepository[categoryEntity.Category, *categoryQuery.CategoryQuery](db),
 	}
 }
+
+// Purge permanently removes a trashed category and moves its children up to
+// its parent. A category that articles still point at, trashed ones included,
+// cannot be purged: articles must always have a category.
+func (r *GormCategoryRepository) Purge(ctx context.Context, id uint) error {
+	return r.PurgeWith(ctx, id, func(tx *gorm.DB) error {
+		var articles int64
+		if err := tx.Unscoped().Model(&articleEntity.Article{}).Where("category_id = ?", id).Count(&articles).Error; err != nil {
+			return err
+		}
+		if articles > 0 {
+			return domainErrors.ErrCategoryInUse
+		}
+
+		return tx.Exec(
+			"UPDATE categories SET parent_id = (SELECT parent_id FROM categories WHERE id = ?) WHERE parent_id = ?",
+			id, id,
+		).Error
+	})
+}
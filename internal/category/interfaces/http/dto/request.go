@@ -1,21 +1,37 @@
 package dto
 
+import (
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	sharedDto "github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+)
+
 type CreateCategoryRequest struct {
-	Name string `binding:"required,max=100" json:"name"`
-	Slug string `binding:"required,max=100" json:"slug"`
+	Name     string `binding:"required"  json:"name"`
+	Slug     string `binding:"required"  json:"slug"`
+	ParentID *uint  `binding:"omitempty" json:"parentId"`
 }
 
+// UpdateCategoryRequest moves the category to the root when ParentID is 0
+// and leaves the parent unchanged when ParentID is omitted.
 type UpdateCategoryRequest struct {
-	Name string `binding:"omitempty,max=100" json:"name"`
-	Slug string `binding:"omitempty,max=100" json:"slug"`
+	sharedDto.Precondition
+
+	Name     string `binding:"omitempty" json:"name"`
+	Slug     string `binding:"omitempty" json:"slug"`
+	ParentID *uint  `binding:"omitempty" json:"parentId"`
 }
 
 func (r CreateCategoryRequest) Validate() error {
-	// Business rules validation
-	return nil
+	return validateCategory(r.Name, r.Slug)
 }
 
 func (r UpdateCategoryRequest) Validate() error {
-	// Business rules validation
-	return nil
+	return validateCategory(r.Name, r.Slug)
+}
+
+func validateCategory(name, slug string) error {
+	var errs sharedDto.ValidationErrors
+	errs.CheckLength("name", name, constants.MinNameLength, constants.MaxNameLength)
+	errs.CheckSlug("slug", slug)
+	return errs.Err()
 }
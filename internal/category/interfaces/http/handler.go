@@ -7,9 +7,11 @@ import (
 	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
 	categoryQuery "github.com/jambo0624/blog/internal/category/domain/query"
 	"github.com/jambo0624/blog/internal/category/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
 	"github.com/jambo0624/blog/internal/shared/domain/constants"
 	"github.com/jambo0624/blog/internal/shared/domain/errors"
 	"github.com/jambo0624/blog/internal/shared/interfaces/http"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
 )
 
 type CategoryHandler struct {
@@ -19,12 +21,14 @@ type CategoryHandler struct {
 		dto.CreateCategoryRequest,
 		dto.UpdateCategoryRequest,
 	]
+	categoryService *categoryService.CategoryService
 }
 
 func NewCategoryHandler(cs *categoryService.CategoryService) *CategoryHandler {
 	baseHandler := http.NewBaseHandler(cs.BaseService, cs)
 	return &CategoryHandler{
-		BaseHandler: baseHandler,
+		BaseHandler:     baseHandler,
+		categoryService: cs,
 	}
 }
 
@@ -72,9 +76,64 @@ func (h *CategoryHandler) buildQuery(c *gin.Context) (*categoryQuery.CategoryQue
 		q.WithOrderBy(orderBy)
 	}
 
+	// Build cursor and count options
+	if err := builder.BuildKeyset(c, &q.BaseQuery); err != nil {
+		return nil, err
+	}
+
+	// Build trash scope
+	builder.BuildDeleted(c, &q.BaseQuery)
+
 	return q, nil
 }
 
 func (h *CategoryHandler) FindAll(c *gin.Context) {
 	h.BaseHandler.FindAll(c, h.buildQuery)
 }
+
+// Update handles PUT /:id requests, rejecting parent changes that would
+// create a cycle.
+func (h *CategoryHandler) Update(c *gin.Context) {
+	id := http.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionUpdate, id) {
+		return
+	}
+
+	var req dto.UpdateCategoryRequest
+	if !http.BindJSON(c, &req) {
+		return
+	}
+	if !http.ApplyIfMatch(c, &req) {
+		return
+	}
+
+	category, err := h.categoryService.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	http.SetETag(c, category)
+	response.Success(c, category)
+}
+
+// Tree handles GET /tree requests.
+func (h *CategoryHandler) Tree(c *gin.Context) {
+	categories, err := h.categoryService.Tree(c.Request.Context())
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.Success(c, categories)
+}
+
+// Breadcrumb handles GET /:id/breadcrumb requests.
+func (h *CategoryHandler) Breadcrumb(c *gin.Context) {
+	id := http.ParseUintParam(c, "id")
+	path, err := h.categoryService.Breadcrumb(c.Request.Context(), id)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.Success(c, path)
+}
@@ -17,8 +17,12 @@ func (r *CategoryRouter) Register(api *gin.RouterGroup) {
 	{
 		categories.POST("", r.handler.Create)
 		categories.GET("", r.handler.FindAll)
+		categories.GET("/tree", r.handler.Tree)
 		categories.GET("/:id", r.handler.FindByID)
+		categories.GET("/:id/breadcrumb", r.handler.Breadcrumb)
 		categories.PUT("/:id", r.handler.Update)
 		categories.DELETE("/:id", r.handler.Delete)
+		categories.POST("/:id/restore", r.handler.Restore)
+		categories.DELETE("/:id/purge", r.handler.Purge)
 	}
 }
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+
+	"github.com/getsentry/sentry-go"
+
+	articleRepository "github.com/jambo0624/blog/internal/article/domain/repository"
+	commentEntity "github.com/jambo0624/blog/internal/comment/domain/entity"
+	"github.com/jambo0624/blog/internal/comment/domain/query"
+	commentRepository "github.com/jambo0624/blog/internal/comment/domain/repository"
+	"github.com/jambo0624/blog/internal/comment/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/application/service"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/clock"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/domain/repository"
+)
+
+type CommentService struct {
+	*service.BaseService[commentEntity.Comment, *query.CommentQuery]
+	commentRepo commentRepository.CommentRepository
+	articleRepo articleRepository.ArticleRepository
+	clock       clock.Clock
+	policy      *auth.Policy
+	uow         repository.UnitOfWork
+}
+
+func NewCommentService(
+	repo commentRepository.CommentRepository,
+	ar articleRepository.ArticleRepository,
+) *CommentService {
+	baseService := service.NewBaseService(repo)
+
+	return &CommentService{
+		BaseService: baseService,
+		commentRepo: repo,
+		articleRepo: ar,
+		clock:       clock.New(),
+		policy:      auth.NewPolicy(),
+		uow:         repository.NewDirectUnitOfWork(),
+	}
+}
+
+// WithClock replaces the clock used for timestamps.
+func (s *CommentService) WithClock(c clock.Clock) *CommentService {
+	s.clock = c
+	return s
+}
+
+// WithUnitOfWork replaces the unit of work that keeps the article's comment
+// count in step with the comment it changes.
+func (s *CommentService) WithUnitOfWork(uow repository.UnitOfWork) *CommentService {
+	s.uow = uow
+	return s
+}
+
+// Authorize checks whether principal may perform action on the comment,
+// taking its author into account. id is 0 for actions on no existing comment.
+func (s *CommentService) Authorize(ctx context.Context, principal *auth.Principal, action auth.Action, id uint) error {
+	if id == 0 || action == auth.ActionCreate || action == auth.ActionRead || action == auth.ActionModerate {
+		return s.policy.Authorize(principal, action, auth.ResourceComment)
+	}
+
+	comment, err := s.findComment(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.policy.AuthorizeOwned(principal, action, auth.ResourceComment, &comment.UserID)
+}
+
+// Create adds a pending comment to a published article.
+func (s *CommentService) Create(ctx context.Context, req *dto.CreateCommentRequest) (*commentEntity.Comment, error) {
+	article, err := s.articleRepo.FindByID(ctx, req.ArticleID)
+	if stdErrors.Is(err, errors.ErrNotFound) {
+		return nil, errors.ErrArticleNotFound
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to find article: %w", err)
+	}
+	if !article.IsPublished() {
+		return nil, errors.ErrCommentsClosed
+	}
+
+	var parent *commentEntity.Comment
+	if req.ParentID != nil {
+		parent, err = s.findComment(ctx, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	comment, err := commentEntity.NewComment(req.ArticleID, req.UserID, parent, req.Content, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	if err := s.commentRepo.Save(ctx, comment); err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to save comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+// Update edits the comment's content, which sends it back for moderation.
+func (s *CommentService) Update(ctx context.Context, id uint, req *dto.UpdateCommentRequest) (*commentEntity.Comment, error) {
+	comment, err := s.findComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	wasApproved := comment.IsApproved()
+	if err := comment.Update(req, s.clock.Now()); err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	if err := s.save(ctx, comment, wasApproved); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// Delete removes the comment. Its replies stay but are no longer shown in
+// the article's thread.
+func (s *CommentService) Delete(ctx context.Context, id uint) error {
+	comment, err := s.findComment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.commentRepo.Delete(ctx, id); err != nil {
+			sentry.CaptureException(err)
+			return fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		if !comment.IsApproved() {
+			return nil
+		}
+		return s.syncCommentCount(ctx, comment.ArticleID)
+	})
+}
+
+// Moderate approves a comment, marks it as spam or returns it to the queue.
+func (s *CommentService) Moderate(ctx context.Context, id uint, req *dto.ModerateCommentRequest) (*commentEntity.Comment, error) {
+	comment, err := s.findComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	wasApproved := comment.IsApproved()
+	if err := comment.Moderate(commentEntity.CommentStatus(req.Status), s.clock.Now()); err != nil {
+		return nil, fmt.Errorf("failed to moderate comment: %w", err)
+	}
+
+	if err := s.save(ctx, comment, wasApproved); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// Thread returns the article's approved comments nested by reply.
+func (s *CommentService) Thread(ctx context.Context, articleID uint) ([]*commentEntity.Comment, error) {
+	comments, err := s.commentRepo.FindApprovedByArticleID(ctx, articleID)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to find comments: %w", err)
+	}
+	return commentEntity.Thread(comments), nil
+}
+
+// findComment loads a comment, answering ErrCommentNotFound only when it does
+// not exist.
+func (s *CommentService) findComment(ctx context.Context, id uint) (*commentEntity.Comment, error) {
+	comment, err := s.commentRepo.FindByID(ctx, id)
+	if stdErrors.Is(err, errors.ErrNotFound) {
+		return nil, errors.ErrCommentNotFound
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to find comment: %w", err)
+	}
+	return comment, nil
+}
+
+// save writes the changed comment and, when it became visible or hidden,
+// recounts the article's comments in the same transaction.
+func (s *CommentService) save(ctx context.Context, comment *commentEntity.Comment, wasApproved bool) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.commentRepo.Update(ctx, comment); err != nil {
+			sentry.CaptureException(err)
+			return fmt.Errorf("failed to update comment: %w", err)
+		}
+
+		if wasApproved == comment.IsApproved() {
+			return nil
+		}
+		return s.syncCommentCount(ctx, comment.ArticleID)
+	})
+}
+
+// syncCommentCount recounts the article's approved comments.
+func (s *CommentService) syncCommentCount(ctx context.Context, articleID uint) error {
+	count, err := s.commentRepo.CountApproved(ctx, articleID)
+	if err != nil {
+		sentry.CaptureException(err)
+		return fmt.Errorf("failed to count comments: %w", err)
+	}
+	if err := s.articleRepo.UpdateCommentCount(ctx, articleID, count); err != nil {
+		sentry.CaptureException(err)
+		return fmt.Errorf("failed to update comment count: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/comment/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	userEntity "github.com/jambo0624/blog/internal/user/domain/entity"
+)
+
+// Comment is a reader's remark on an article, optionally replying to
+// another comment. New comments wait in the moderation queue until an
+// editor approves them.
+type Comment struct {
+	ID          uint               `gorm:"primaryKey"                         json:"id"`
+	ArticleID   uint               `gorm:"not null;index"                     json:"articleId"`
+	ParentID    *uint              `gorm:"index"                              json:"parentId"`
+	Replies     []*Comment         `gorm:"foreignKey:ParentID"                json:"replies,omitempty"`
+	UserID      uint               `gorm:"not null;index"                     json:"userId"`
+	Author      *userEntity.Author `gorm:"foreignKey:UserID"                  json:"author,omitempty"`
+	Content     string             `gorm:"type:text;not null"                 json:"content"`
+	Status      CommentStatus      `gorm:"size:20;default:pending;index"      json:"status"`
+	ModeratedAt *time.Time         `json:"moderatedAt"`
+	CreatedAt   time.Time          `gorm:"not null;default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt   time.Time          `gorm:"not null;default:CURRENT_TIMESTAMP" json:"updatedAt"`
+	DeletedAt   gorm.DeletedAt     `gorm:"index"                              json:"deletedAt"`
+}
+
+// NewComment creates a pending comment on the article. parent is nil for
+// top-level comments, otherwise it must be an approved comment on the same
+// article.
+func NewComment(articleID, userID uint, parent *Comment, content string, now time.Time) (*Comment, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, errors.ErrContentRequired
+	}
+	if len(content) > constants.MaxCommentLength {
+		return nil, errors.ErrContentTooLong
+	}
+
+	comment := &Comment{
+		ArticleID: articleID,
+		UserID:    userID,
+		Content:   content,
+		Status:    StatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if parent != nil {
+		if parent.ArticleID != articleID {
+			return nil, errors.ErrCommentParentMismatch
+		}
+		if !parent.IsApproved() {
+			return nil, errors.ErrCommentParentHidden
+		}
+		comment.ParentID = &parent.ID
+	}
+	return comment, nil
+}
+
+// GetID get comment id, implement Entity interface.
+func (c Comment) GetID() uint {
+	return c.ID
+}
+
+// GetUpdatedAt get comment last modification time, implement Timestamped interface.
+func (c Comment) GetUpdatedAt() time.Time {
+	return c.UpdatedAt
+}
+
+// EmbedsDerivedData reports that the comment carries its author and replies,
+// implement Composite interface.
+func (Comment) EmbedsDerivedData() bool {
+	return true
+}
+
+// Update replaces the content. An edited comment goes back to the
+// moderation queue so approved comments cannot be changed unreviewed.
+func (c *Comment) Update(req *dto.UpdateCommentRequest, now time.Time) error {
+	content := strings.TrimSpace(req.Content)
+	if content == "" {
+		return errors.ErrContentRequired
+	}
+	if len(content) > constants.MaxCommentLength {
+		return errors.ErrContentTooLong
+	}
+	if content == c.Content {
+		return nil
+	}
+
+	c.Content = content
+	c.Status = StatusPending
+	c.UpdatedAt = now
+	return nil
+}
+
+// Moderate sets the moderation status.
+func (c *Comment) Moderate(status CommentStatus, now time.Time) error {
+	if !status.IsValid() {
+		return errors.ErrInvalidStatus
+	}
+	c.Status = status
+	c.ModeratedAt = &now
+	c.UpdatedAt = now
+	return nil
+}
+
+// IsApproved reports whether the comment is publicly visible.
+func (c *Comment) IsApproved() bool {
+	return c.Status == StatusApproved
+}
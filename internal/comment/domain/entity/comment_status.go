@@ -0,0 +1,19 @@
+package entity
+
+// CommentStatus represents the moderation state of a comment.
+type CommentStatus string
+
+const (
+	StatusPending  CommentStatus = "pending"
+	StatusApproved CommentStatus = "approved"
+	StatusSpam     CommentStatus = "spam"
+)
+
+// IsValid reports whether the status is one of the known states.
+func (s CommentStatus) IsValid() bool {
+	switch s {
+	case StatusPending, StatusApproved, StatusSpam:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package entity
+
+// Thread nests comments under their parents and returns the top-level
+// comments in the order given. Replies whose parent is not in comments are
+// dropped, so a hidden comment hides its whole subthread.
+func Thread(comments []*Comment) []*Comment {
+	byID := make(map[uint]*Comment, len(comments))
+	for _, comment := range comments {
+		comment.Replies = nil
+		byID[comment.ID] = comment
+	}
+
+	roots := make([]*Comment, 0, len(comments))
+	for _, comment := range comments {
+		if comment.ParentID == nil {
+			roots = append(roots, comment)
+			continue
+		}
+		if parent, ok := byID[*comment.ParentID]; ok {
+			parent.Replies = append(parent.Replies, comment)
+		}
+	}
+	return roots
+}
@@ -0,0 +1,82 @@
+package query
+
+import (
+	"gorm.io/gorm"
+
+	baseQuery "github.com/jambo0624/blog/internal/shared/domain/query"
+)
+
+// Preload constants for Comment queries.
+const (
+	PreloadAuthor = "Author"
+)
+
+type CommentQuery struct {
+	baseQuery.BaseQuery
+	ArticleID           *uint    `binding:"omitempty" json:"articleId"           validate:"omitempty,gt=0"`
+	UserID              *uint    `binding:"omitempty" json:"userId"              validate:"omitempty,gt=0"`
+	Statuses            []string `binding:"omitempty" json:"statuses"            validate:"omitempty,dive,oneof=pending approved spam"`
+	PreloadAssociations []string `binding:"omitempty" json:"preloadAssociations"`
+}
+
+func NewCommentQuery() *CommentQuery {
+	return &CommentQuery{
+		BaseQuery:           baseQuery.NewBaseQuery(),
+		PreloadAssociations: getDefaultPreloads(),
+	}
+}
+
+func (q *CommentQuery) WithArticleID(id uint) *CommentQuery {
+	q.ArticleID = &id
+
+	return q
+}
+
+func (q *CommentQuery) WithUserID(id uint) *CommentQuery {
+	q.UserID = &id
+
+	return q
+}
+
+func (q *CommentQuery) WithStatuses(statuses ...string) *CommentQuery {
+	q.Statuses = statuses
+
+	return q
+}
+
+func (q *CommentQuery) Validate() error {
+	return q.BaseQuery.ValidateQuery(q)
+}
+
+func (q *CommentQuery) GetBaseQuery() baseQuery.BaseQuery {
+	return q.BaseQuery
+}
+
+func (q *CommentQuery) GetPreloadAssociations() []string {
+	return q.PreloadAssociations
+}
+
+func (q *CommentQuery) ApplyFilters(db *gorm.DB) *gorm.DB {
+	if len(q.IDs) > 0 {
+		db = db.Where("comments.id IN ?", q.IDs)
+	}
+
+	if q.ArticleID != nil {
+		db = db.Where("comments.article_id = ?", q.ArticleID)
+	}
+
+	if q.UserID != nil {
+		db = db.Where("comments.user_id = ?", q.UserID)
+	}
+
+	if len(q.Statuses) > 0 {
+		db = db.Where("comments.status IN ?", q.Statuses)
+	}
+
+	return db
+}
+
+// getDefaultPreloads returns default preload associations for Comment queries.
+func getDefaultPreloads() []string {
+	return []string{PreloadAuthor}
+}
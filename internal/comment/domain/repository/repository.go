@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	commentEntity "github.com/jambo0624/blog/internal/comment/domain/entity"
+	commentQuery "github.com/jambo0624/blog/internal/comment/domain/query"
+	"github.com/jambo0624/blog/internal/shared/domain/repository"
+)
+
+type CommentRepository interface {
+	repository.BaseRepository[commentEntity.Comment, *commentQuery.CommentQuery]
+	// FindApprovedByArticleID returns the article's approved comments,
+	// oldest first, with their authors.
+	FindApprovedByArticleID(ctx context.Context, articleID uint) ([]*commentEntity.Comment, error)
+	// CountApproved counts the article's approved comments.
+	CountApproved(ctx context.Context, articleID uint) (int64, error)
+}
@@ -0,0 +1,47 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	commentEntity "github.com/jambo0624/blog/internal/comment/domain/entity"
+	commentQuery "github.com/jambo0624/blog/internal/comment/domain/query"
+	commentRepository "github.com/jambo0624/blog/internal/comment/domain/repository"
+	persistence "github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
+)
+
+type GormCommentRepository struct {
+	*persistence.BaseGormRepository[commentEntity.Comment, *commentQuery.CommentQuery]
+	db *gorm.DB
+}
+
+func NewGormCommentRepository(db *gorm.DB) commentRepository.CommentRepository {
+	return &GormCommentRepository{
+		BaseGormRepository: persistence.NewBaseGormRepository[commentEntity.Comment, *commentQuery.CommentQuery](db),
+		db:                 db,
+	}
+}
+
+func (r *GormCommentRepository) FindApprovedByArticleID(ctx context.Context, articleID uint) ([]*commentEntity.Comment, error) {
+	var comments []*commentEntity.Comment
+	err := r.approved(ctx, articleID).
+		Preload(commentQuery.PreloadAuthor).
+		Order("created_at ASC, id ASC").
+		Find(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (r *GormCommentRepository) CountApproved(ctx context.Context, articleID uint) (int64, error) {
+	var count int64
+	err := r.approved(ctx, articleID).Count(&count).Error
+	return count, err
+}
+
+func (r *GormCommentRepository) approved(ctx context.Context, articleID uint) *gorm.DB {
+	return persistence.Conn(ctx, r.db).Model(&commentEntity.Comment{}).
+		Where("article_id = ? AND status = ?", articleID, commentEntity.StatusApproved)
+}
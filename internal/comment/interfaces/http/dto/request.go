@@ -0,0 +1,28 @@
+package dto
+
+// CreateCommentRequest takes ArticleID from the path and UserID from the
+// authenticated user, never from the request body.
+type CreateCommentRequest struct {
+	ArticleID uint   `json:"-"`
+	UserID    uint   `json:"-"`
+	ParentID  *uint  `binding:"omitempty,gt=0"    json:"parentId"`
+	Content   string `binding:"required,max=2000" json:"content"`
+}
+
+type UpdateCommentRequest struct {
+	Content string `binding:"required,max=2000" json:"content"`
+}
+
+type ModerateCommentRequest struct {
+	Status string `binding:"required,oneof=pending approved spam" json:"status"`
+}
+
+func (r CreateCommentRequest) Validate() error {
+	// Business rules validation
+	return nil
+}
+
+func (r UpdateCommentRequest) Validate() error {
+	// Business rules validation
+	return nil
+}
@@ -0,0 +1,225 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	commentService "github.com/jambo0624/blog/internal/comment/application/service"
+	commentEntity "github.com/jambo0624/blog/internal/comment/domain/entity"
+	commentQuery "github.com/jambo0624/blog/internal/comment/domain/query"
+	"github.com/jambo0624/blog/internal/comment/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	sharedHttp "github.com/jambo0624/blog/internal/shared/interfaces/http"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+)
+
+type CommentHandler struct {
+	*sharedHttp.BaseHandler[
+		commentEntity.Comment,
+		*commentQuery.CommentQuery,
+		dto.CreateCommentRequest,
+		dto.UpdateCommentRequest,
+	]
+	commentService *commentService.CommentService
+}
+
+func NewCommentHandler(cs *commentService.CommentService) *CommentHandler {
+	baseHandler := sharedHttp.NewBaseHandler(cs.BaseService, cs)
+
+	return &CommentHandler{
+		BaseHandler:    baseHandler,
+		commentService: cs,
+	}
+}
+
+func (h *CommentHandler) buildQuery(c *gin.Context) (*commentQuery.CommentQuery, error) {
+	q := commentQuery.NewCommentQuery()
+	builder := sharedHttp.NewBaseQueryBuilder()
+
+	ids, err := builder.BuildIDs(c)
+	if err != nil {
+		return nil, err
+	}
+	if ids != nil {
+		q.WithIDs(ids)
+	}
+
+	if articleID := c.Query("article_id"); articleID != "" {
+		uid, err := strconv.ParseUint(articleID, 10, 32)
+		if err != nil {
+			return nil, errors.ErrInvalidIDFormat
+		}
+		q.WithArticleID(uint(uid))
+	}
+
+	if userID := c.Query("user_id"); userID != "" {
+		uid, err := strconv.ParseUint(userID, 10, 32)
+		if err != nil {
+			return nil, errors.ErrInvalidIDFormat
+		}
+		q.WithUserID(uint(uid))
+	}
+
+	limit, offset, err := builder.BuildPagination(c, q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	q.WithPagination(limit, offset)
+
+	orderBy, err := builder.BuildOrderBy(c, map[string]bool{
+		"created_at": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if orderBy != "" {
+		q.WithOrderBy(orderBy)
+	}
+
+	if err := builder.BuildKeyset(c, &q.BaseQuery); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// FindAll handles GET / requests, listing approved comments only.
+func (h *CommentHandler) FindAll(c *gin.Context) {
+	h.BaseHandler.FindAll(c, func(c *gin.Context) (*commentQuery.CommentQuery, error) {
+		q, err := h.buildQuery(c)
+		if err != nil {
+			return nil, err
+		}
+		return q.WithStatuses(string(commentEntity.StatusApproved)), nil
+	})
+}
+
+// FindByID handles GET /:id requests. Comments awaiting moderation or
+// marked as spam are not public.
+func (h *CommentHandler) FindByID(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	comment, err := h.Service.FindByID(c.Request.Context(), id, commentQuery.PreloadAuthor)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	if !comment.IsApproved() {
+		response.NotFound(c)
+		return
+	}
+	sharedHttp.RespondEntity(c, comment)
+}
+
+// ModerationQueue handles GET /moderation requests, listing pending
+// comments unless another status is requested.
+func (h *CommentHandler) ModerationQueue(c *gin.Context) {
+	if !h.Authorize(c, auth.ActionModerate, 0) {
+		return
+	}
+
+	status := c.DefaultQuery("status", string(commentEntity.StatusPending))
+	if !commentEntity.CommentStatus(status).IsValid() {
+		response.BadRequest(c, errors.ErrInvalidStatus)
+		return
+	}
+
+	h.BaseHandler.FindAll(c, func(c *gin.Context) (*commentQuery.CommentQuery, error) {
+		q, err := h.buildQuery(c)
+		if err != nil {
+			return nil, err
+		}
+		return q.WithStatuses(status), nil
+	})
+}
+
+// Thread handles GET /articles/:id/comments requests.
+func (h *CommentHandler) Thread(c *gin.Context) {
+	articleID := sharedHttp.ParseUintParam(c, "id")
+	comments, err := h.commentService.Thread(c.Request.Context(), articleID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.Success(c, comments)
+}
+
+// Create handles POST /articles/:id/comments requests.
+func (h *CommentHandler) Create(c *gin.Context) {
+	if !h.Authorize(c, auth.ActionCreate, 0) {
+		return
+	}
+
+	var req dto.CreateCommentRequest
+	if !sharedHttp.BindJSON(c, &req) {
+		return
+	}
+
+	req.ArticleID = sharedHttp.ParseUintParam(c, "id")
+	if principal, ok := middleware.PrincipalFrom(c); ok {
+		req.UserID = principal.UserID
+	}
+
+	comment, err := h.commentService.Create(c.Request.Context(), &req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.Created(c, comment)
+}
+
+// Update handles PUT /:id requests.
+func (h *CommentHandler) Update(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionUpdate, id) {
+		return
+	}
+
+	var req dto.UpdateCommentRequest
+	if !sharedHttp.BindJSON(c, &req) {
+		return
+	}
+
+	comment, err := h.commentService.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.Success(c, comment)
+}
+
+// Delete handles DELETE /:id requests.
+func (h *CommentHandler) Delete(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionDelete, id) {
+		return
+	}
+
+	if err := h.commentService.Delete(c.Request.Context(), id); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.NoContent(c)
+}
+
+// Moderate handles POST /:id/moderate requests.
+func (h *CommentHandler) Moderate(c *gin.Context) {
+	id := sharedHttp.ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionModerate, id) {
+		return
+	}
+
+	var req dto.ModerateCommentRequest
+	if !sharedHttp.BindJSON(c, &req) {
+		return
+	}
+
+	comment, err := h.commentService.Moderate(c.Request.Context(), id, &req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.Success(c, comment)
+}
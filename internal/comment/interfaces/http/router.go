@@ -0,0 +1,42 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+)
+
+type CommentRouter struct {
+	handler       *CommentHandler
+	authenticator middleware.Authenticator
+}
+
+func NewCommentRouter(handler *CommentHandler, authenticator middleware.Authenticator) *CommentRouter {
+	return &CommentRouter{
+		handler:       handler,
+		authenticator: authenticator,
+	}
+}
+
+func (r *CommentRouter) Register(api *gin.RouterGroup) {
+	articleComments := api.Group("/articles/:id/comments")
+	{
+		articleComments.GET("", r.handler.Thread)
+		articleComments.POST("", r.handler.Create)
+	}
+
+	comments := api.Group("/comments")
+	{
+		comments.GET("", r.handler.FindAll)
+		comments.GET("/:id", r.handler.FindByID)
+		comments.PUT("/:id", r.handler.Update)
+		comments.DELETE("/:id", r.handler.Delete)
+	}
+
+	// the moderation queue is read with GET, so it needs its own auth
+	moderation := comments.Group("", middleware.RequireAuth(r.authenticator))
+	{
+		moderation.GET("/moderation", r.handler.ModerationQueue)
+		moderation.POST("/:id/moderate", r.handler.Moderate)
+	}
+}
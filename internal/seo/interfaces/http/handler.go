@@ -0,0 +1,258 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	articleService "github.com/jambo0624/blog/internal/article/application/service"
+	categoryService "github.com/jambo0624/blog/internal/category/application/service"
+	categoryQuery "github.com/jambo0624/blog/internal/category/domain/query"
+	"github.com/jambo0624/blog/internal/seo/interfaces/http/sitemap"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+	tagService "github.com/jambo0624/blog/internal/tag/application/service"
+	tagQuery "github.com/jambo0624/blog/internal/tag/domain/query"
+)
+
+// SEOOptions describes the site and the crawler rules.
+type SEOOptions struct {
+	SiteURL        string   // Public base URL without trailing slash
+	SitemapSize    int      // URLs per sitemap, sitemap.MaxURLs when zero
+	RobotsAllow    []string // Paths crawlers may visit inside disallowed ones
+	RobotsDisallow []string // Paths crawlers should stay out of
+}
+
+// sitemapPage matches the names of the sitemaps listed in the index, e.g.
+// articles-2.xml.
+var sitemapPage = regexp.MustCompile(`^([a-z]+)-([1-9]\d*)\.xml$`)
+
+// sitemapSource is one kind of page listed in the sitemap.
+type sitemapSource struct {
+	name  string
+	count func(ctx context.Context) (int64, error)
+	urls  func(ctx context.Context, limit, offset int) ([]sitemap.URL, error)
+}
+
+// SEOHandler serves robots.txt and the sitemap of the published articles,
+// the categories and the tags. Up to SitemapSize URLs fit in /sitemap.xml;
+// beyond that it becomes an index of numbered sitemaps per kind.
+type SEOHandler struct {
+	options SEOOptions
+	sources []sitemapSource
+}
+
+func NewSEOHandler(
+	as *articleService.ArticleService,
+	cs *categoryService.CategoryService,
+	ts *tagService.TagService,
+	options SEOOptions,
+) *SEOHandler {
+	if options.SitemapSize <= 0 || options.SitemapSize > sitemap.MaxURLs {
+		options.SitemapSize = sitemap.MaxURLs
+	}
+
+	h := &SEOHandler{options: options}
+	h.sources = []sitemapSource{
+		h.articleSource(as),
+		h.categorySource(cs),
+		h.tagSource(ts),
+	}
+	return h
+}
+
+// Robots handles GET /robots.txt requests.
+func (h *SEOHandler) Robots(c *gin.Context) {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	for _, path := range h.options.RobotsAllow {
+		fmt.Fprintf(&b, "Allow: %s\n", path)
+	}
+	if len(h.options.RobotsDisallow) == 0 {
+		// an empty rule allows everything
+		b.WriteString("Disallow:\n")
+	}
+	for _, path := range h.options.RobotsDisallow {
+		fmt.Fprintf(&b, "Disallow: %s\n", path)
+	}
+	fmt.Fprintf(&b, "\nSitemap: %s/sitemap.xml\n", h.options.SiteURL)
+
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(b.String()))
+}
+
+// Sitemap handles GET /sitemap.xml requests.
+func (h *SEOHandler) Sitemap(c *gin.Context) {
+	ctx := c.Request.Context()
+	counts := make([]int64, len(h.sources))
+	var total int64
+	for i, source := range h.sources {
+		count, err := source.count(ctx)
+		if err != nil {
+			response.InternalError(c, err)
+			return
+		}
+		counts[i] = count
+		total += count
+	}
+
+	if total <= int64(h.options.SitemapSize) {
+		var urls []sitemap.URL
+		for _, source := range h.sources {
+			page, err := source.urls(ctx, h.options.SitemapSize, 0)
+			if err != nil {
+				response.InternalError(c, err)
+				return
+			}
+			urls = append(urls, page...)
+		}
+		h.render(c, sitemap.URLSet, urls)
+		return
+	}
+
+	var sitemaps []sitemap.URL
+	size := int64(h.options.SitemapSize)
+	for i, source := range h.sources {
+		for page := int64(1); page <= (counts[i]+size-1)/size; page++ {
+			sitemaps = append(sitemaps, sitemap.URL{
+				Loc: fmt.Sprintf("%s/sitemaps/%s-%d.xml", h.options.SiteURL, source.name, page),
+			})
+		}
+	}
+	h.render(c, sitemap.Index, sitemaps)
+}
+
+// SitemapPage handles GET /sitemaps/:name requests for the sitemaps listed
+// in the index.
+func (h *SEOHandler) SitemapPage(c *gin.Context) {
+	match := sitemapPage.FindStringSubmatch(c.Param("name"))
+	if match == nil {
+		response.NotFound(c)
+		return
+	}
+	page, err := strconv.Atoi(match[2])
+	if err != nil {
+		response.NotFound(c)
+		return
+	}
+
+	for _, source := range h.sources {
+		if source.name != match[1] {
+			continue
+		}
+		urls, err := source.urls(c.Request.Context(), h.options.SitemapSize, (page-1)*h.options.SitemapSize)
+		if err != nil {
+			response.InternalError(c, err)
+			return
+		}
+		if len(urls) == 0 {
+			response.NotFound(c)
+			return
+		}
+		h.render(c, sitemap.URLSet, urls)
+		return
+	}
+	response.NotFound(c)
+}
+
+func (h *SEOHandler) render(c *gin.Context, render func([]sitemap.URL) ([]byte, error), urls []sitemap.URL) {
+	body, err := render(urls)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+	c.Data(http.StatusOK, sitemap.ContentType, body)
+}
+
+// The sources page through their entities by ID, so consecutive sitemaps
+// neither overlap nor skip entries while nothing is added. Articles are read
+// as bare links; categories and tags count by fetching a single row along
+// with the total.
+
+func (h *SEOHandler) articleSource(s *articleService.ArticleService) sitemapSource {
+	return sitemapSource{
+		name:  "articles",
+		count: s.CountPublished,
+		urls: func(ctx context.Context, limit, offset int) ([]sitemap.URL, error) {
+			links, err := s.FindPublishedLinks(ctx, limit, offset)
+			if err != nil {
+				return nil, err
+			}
+			urls := make([]sitemap.URL, 0, len(links))
+			for _, link := range links {
+				urls = append(urls, sitemap.URL{
+					Loc:     fmt.Sprintf("%s/articles/%s", h.options.SiteURL, link.Slug),
+					LastMod: link.UpdatedAt,
+				})
+			}
+			return urls, nil
+		},
+	}
+}
+
+func (h *SEOHandler) categorySource(s *categoryService.CategoryService) sitemapSource {
+	query := func(limit, offset int) *categoryQuery.CategoryQuery {
+		q := categoryQuery.NewCategoryQuery()
+		q.WithPagination(limit, offset)
+		return q
+	}
+
+	return sitemapSource{
+		name: "categories",
+		count: func(ctx context.Context) (int64, error) {
+			_, total, err := s.FindAll(ctx, query(1, 0))
+			return total, err
+		},
+		urls: func(ctx context.Context, limit, offset int) ([]sitemap.URL, error) {
+			q := query(limit, offset)
+			q.WithoutCount()
+			categories, _, err := s.FindAll(ctx, q)
+			if err != nil {
+				return nil, err
+			}
+			urls := make([]sitemap.URL, 0, len(categories))
+			for _, category := range categories {
+				urls = append(urls, sitemap.URL{
+					Loc:     fmt.Sprintf("%s/categories/%s", h.options.SiteURL, category.Slug),
+					LastMod: category.UpdatedAt,
+				})
+			}
+			return urls, nil
+		},
+	}
+}
+
+func (h *SEOHandler) tagSource(s *tagService.TagService) sitemapSource {
+	query := func(limit, offset int) *tagQuery.TagQuery {
+		q := tagQuery.NewTagQuery()
+		q.WithPagination(limit, offset)
+		return q
+	}
+
+	return sitemapSource{
+		name: "tags",
+		count: func(ctx context.Context) (int64, error) {
+			_, total, err := s.FindAll(ctx, query(1, 0))
+			return total, err
+		},
+		urls: func(ctx context.Context, limit, offset int) ([]sitemap.URL, error) {
+			q := query(limit, offset)
+			q.WithoutCount()
+			tags, _, err := s.FindAll(ctx, q)
+			if err != nil {
+				return nil, err
+			}
+			urls := make([]sitemap.URL, 0, len(tags))
+			for _, tag := range tags {
+				urls = append(urls, sitemap.URL{
+					Loc:     fmt.Sprintf("%s/tags/%d", h.options.SiteURL, tag.ID),
+					LastMod: tag.UpdatedAt,
+				})
+			}
+			return urls, nil
+		},
+	}
+}
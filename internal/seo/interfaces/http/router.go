@@ -0,0 +1,20 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type SEORouter struct {
+	handler *SEOHandler
+}
+
+func NewSEORouter(handler *SEOHandler) *SEORouter {
+	return &SEORouter{handler: handler}
+}
+
+// Register adds robots.txt and the sitemaps at the site root.
+func (r *SEORouter) Register(root *gin.RouterGroup) {
+	root.GET("/robots.txt", r.handler.Robots)
+	root.GET("/sitemap.xml", r.handler.Sitemap)
+	root.GET("/sitemaps/:name", r.handler.SitemapPage)
+}
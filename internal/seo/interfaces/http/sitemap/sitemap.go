@@ -0,0 +1,71 @@
+// Package sitemap renders sitemaps and sitemap indexes following the
+// sitemaps.org protocol.
+package sitemap
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// MaxURLs is the number of URLs the protocol allows in a single sitemap, and
+// the number of sitemaps it allows in an index.
+const MaxURLs = 50000
+
+// ContentType is the content type of the rendered documents.
+const ContentType = "application/xml; charset=utf-8"
+
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// URL is a page of a sitemap, or a sitemap of an index. LastMod is left out
+// when zero.
+type URL struct {
+	Loc     string
+	LastMod time.Time
+}
+
+type urlSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	NS      string   `xml:"xmlns,attr"`
+	URLs    []entry  `xml:"url"`
+}
+
+type index struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	NS       string   `xml:"xmlns,attr"`
+	Sitemaps []entry  `xml:"sitemap"`
+}
+
+type entry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// URLSet renders urls as a sitemap.
+func URLSet(urls []URL) ([]byte, error) {
+	return marshalXML(urlSet{NS: namespace, URLs: entries(urls)})
+}
+
+// Index renders a sitemap index pointing at sitemaps.
+func Index(sitemaps []URL) ([]byte, error) {
+	return marshalXML(index{NS: namespace, Sitemaps: entries(sitemaps)})
+}
+
+func entries(urls []URL) []entry {
+	result := make([]entry, 0, len(urls))
+	for _, u := range urls {
+		e := entry{Loc: u.Loc}
+		if !u.LastMod.IsZero() {
+			e.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/getsentry/sentry-go"
@@ -20,8 +21,8 @@ func NewBaseService[T repository.Entity, Q repository.Query](
 	}
 }
 
-func (s *BaseService[T, Q]) FindByID(id uint, preloadAssociations ...string) (*T, error) {
-	if entity, err := s.Repo.FindByID(id, preloadAssociations...); err != nil {
+func (s *BaseService[T, Q]) FindByID(ctx context.Context, id uint, preloadAssociations ...string) (*T, error) {
+	if entity, err := s.Repo.FindByID(ctx, id, preloadAssociations...); err != nil {
 		sentry.CaptureException(err)
 		return nil, fmt.Errorf("failed to find entity by id: %w", err)
 	} else {
@@ -29,13 +30,13 @@ func (s *BaseService[T, Q]) FindByID(id uint, preloadAssociations ...string) (*T
 	}
 }
 
-func (s *BaseService[T, Q]) FindAll(query Q) ([]*T, int64, error) {
+func (s *BaseService[T, Q]) FindAll(ctx context.Context, query Q) ([]*T, int64, error) {
 	if err := query.Validate(); err != nil {
 		sentry.CaptureException(err)
 		return nil, 0, fmt.Errorf("failed to validate query: %w", err)
 	}
 
-	if entities, total, err := s.Repo.FindAll(query); err != nil {
+	if entities, total, err := s.Repo.FindAll(ctx, query); err != nil {
 		sentry.CaptureException(err)
 		return nil, 0, fmt.Errorf("failed to find all entities: %w", err)
 	} else {
@@ -43,10 +44,26 @@ func (s *BaseService[T, Q]) FindAll(query Q) ([]*T, int64, error) {
 	}
 }
 
-func (s *BaseService[T, Q]) Delete(id uint) error {
-	if err := s.Repo.Delete(id); err != nil {
+func (s *BaseService[T, Q]) Delete(ctx context.Context, id uint) error {
+	if err := s.Repo.Delete(ctx, id); err != nil {
 		sentry.CaptureException(err)
 		return fmt.Errorf("failed to delete entity by id: %w", err)
 	}
 	return nil
 }
+
+func (s *BaseService[T, Q]) Restore(ctx context.Context, id uint) error {
+	if err := s.Repo.Restore(ctx, id); err != nil {
+		sentry.CaptureException(err)
+		return fmt.Errorf("failed to restore entity by id: %w", err)
+	}
+	return nil
+}
+
+func (s *BaseService[T, Q]) Purge(ctx context.Context, id uint) error {
+	if err := s.Repo.Purge(ctx, id); err != nil {
+		sentry.CaptureException(err)
+		return fmt.Errorf("failed to purge entity by id: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+)
+
+// ResourceGuard authorizes actions on a resource whose entities have no
+// owner, so the principal's role alone decides.
+type ResourceGuard struct {
+	Policy   *auth.Policy
+	Resource auth.Resource
+}
+
+func NewResourceGuard(resource auth.Resource) *ResourceGuard {
+	return &ResourceGuard{
+		Policy:   auth.NewPolicy(),
+		Resource: resource,
+	}
+}
+
+// Authorize checks whether principal may perform action on the entity.
+func (g *ResourceGuard) Authorize(_ context.Context, principal *auth.Principal, action auth.Action, _ uint) error {
+	return g.Policy.Authorize(principal, action, g.Resource)
+}
@@ -0,0 +1,148 @@
+package auth
+
+import "github.com/jambo0624/blog/internal/shared/domain/errors"
+
+// Action is an operation a principal attempts on a resource.
+type Action string
+
+const (
+	ActionRead     Action = "read"
+	ActionCreate   Action = "create"
+	ActionUpdate   Action = "update"
+	ActionDelete   Action = "delete"
+	ActionPublish  Action = "publish"
+	ActionModerate Action = "moderate"
+	ActionRestore  Action = "restore"
+	ActionPurge    Action = "purge"
+
+	// ActionReadUnpublished reads an entity the public cannot see yet, such
+	// as a draft or scheduled article. Unlike ActionRead it is never public.
+	ActionReadUnpublished Action = "read_unpublished"
+)
+
+// Resource names a kind of entity guarded by the policy.
+type Resource string
+
+const (
+	ResourceArticle  Resource = "article"
+	ResourceCategory Resource = "category"
+	ResourceTag      Resource = "tag"
+	ResourceUser     Resource = "user"
+	ResourceComment  Resource = "comment"
+)
+
+// Scope limits a grant to the principal's own entities or extends it to all.
+type Scope int
+
+const (
+	ScopeNone Scope = iota
+	ScopeOwn
+	ScopeAny
+)
+
+type grants map[Resource]map[Action]Scope
+
+// ownComments lets a role post comments and edit or delete its own.
+var ownComments = map[Action]Scope{
+	ActionCreate: ScopeAny,
+	ActionUpdate: ScopeOwn,
+	ActionDelete: ScopeOwn,
+}
+
+// Policy decides which roles may perform which actions.
+type Policy struct {
+	public map[Resource]bool
+	roles  map[Role]grants
+}
+
+// NewPolicy returns the blog's editorial rules: admins do everything,
+// editors manage and read any article, restore trashed ones and moderate
+// comments, authors manage and read their own articles and every role can
+// comment and manage its own comments. Only admins purge. Published content
+// is readable without logging in.
+func NewPolicy() *Policy {
+	return &Policy{
+		public: map[Resource]bool{
+			ResourceArticle:  true,
+			ResourceCategory: true,
+			ResourceTag:      true,
+			ResourceComment:  true,
+		},
+		roles: map[Role]grants{
+			RoleEditor: {
+				ResourceArticle: {
+					ActionReadUnpublished: ScopeAny,
+					ActionCreate:          ScopeAny,
+					ActionUpdate:          ScopeAny,
+					ActionDelete:          ScopeAny,
+					ActionPublish:         ScopeAny,
+					ActionRestore:         ScopeAny,
+				},
+				ResourceComment: {
+					ActionCreate:   ScopeAny,
+					ActionUpdate:   ScopeAny,
+					ActionDelete:   ScopeAny,
+					ActionModerate: ScopeAny,
+				},
+			},
+			RoleAuthor: {
+				ResourceArticle: {
+					ActionReadUnpublished: ScopeOwn,
+					ActionCreate:          ScopeAny,
+					ActionUpdate:          ScopeOwn,
+					ActionDelete:          ScopeOwn,
+				},
+				ResourceComment: ownComments,
+			},
+			RoleReader: {
+				ResourceComment: ownComments,
+			},
+		},
+	}
+}
+
+// Authorize checks the action against the principal's role alone. Grants
+// limited to own entities pass here and must be rechecked with
+// AuthorizeOwned once the entity is known.
+func (p *Policy) Authorize(principal *Principal, action Action, resource Resource) error {
+	scope, err := p.scope(principal, action, resource)
+	if err != nil {
+		return err
+	}
+	if scope == ScopeNone {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
+// AuthorizeOwned checks the action against an entity owned by ownerID,
+// which is nil for entities without an owner.
+func (p *Policy) AuthorizeOwned(principal *Principal, action Action, resource Resource, ownerID *uint) error {
+	scope, err := p.scope(principal, action, resource)
+	if err != nil {
+		return err
+	}
+
+	switch scope {
+	case ScopeAny:
+		return nil
+	case ScopeOwn:
+		if ownerID != nil && *ownerID == principal.UserID {
+			return nil
+		}
+	}
+	return errors.ErrForbidden
+}
+
+func (p *Policy) scope(principal *Principal, action Action, resource Resource) (Scope, error) {
+	if action == ActionRead && p.public[resource] {
+		return ScopeAny, nil
+	}
+	if principal == nil {
+		return ScopeNone, errors.ErrUnauthenticated
+	}
+	if principal.Role == RoleAdmin {
+		return ScopeAny, nil
+	}
+	return p.roles[principal.Role][resource][action], nil
+}
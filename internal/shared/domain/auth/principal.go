@@ -0,0 +1,16 @@
+package auth
+
+// Method records how a request was authenticated.
+type Method string
+
+const (
+	MethodJWT    Method = "jwt"
+	MethodAPIKey Method = "api_key"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UserID uint
+	Role   Role
+	Method Method
+}
@@ -0,0 +1,20 @@
+package auth
+
+// Role is the set of permissions granted to a user.
+type Role string
+
+const (
+	RoleAdmin  Role = "admin"
+	RoleEditor Role = "editor"
+	RoleAuthor Role = "author"
+	RoleReader Role = "reader"
+)
+
+// IsValid reports whether the role is one of the known roles.
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleEditor, RoleAuthor, RoleReader:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package clock
+
+import "time"
+
+// Clock provides the current time, allowing tests to control it.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock returns the wall clock time.
+type SystemClock struct{}
+
+// New creates a clock backed by time.Now.
+func New() Clock {
+	return SystemClock{}
+}
+
+// Now returns the current local time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
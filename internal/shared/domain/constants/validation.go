@@ -21,4 +21,14 @@ const (
 
 	// Color limits.
 	MaxColorLength = 50
+
+	// Password limits, bcrypt ignores input past 72 bytes.
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+
+	// Email limits.
+	MaxEmailLength = 255
+
+	// Comment limits.
+	MaxCommentLength = 2000
 )
@@ -1,41 +1,109 @@
 package errors
 
-import "errors"
-
-// Validation errors.
+// Domain errors. Each carries the Kind the interface layer maps to a
+// response.
 var (
+	// Persistence.
+	ErrNotFound   = New(KindNotFound, "resource not found")
+	ErrDuplicate  = New(KindConflict, "resource already exists")
+	ErrReferenced = New(KindConflict, "resource is referenced by another resource")
+
 	// ID.
-	ErrInvalidIDFormat = errors.New("invalid id format")
+	ErrInvalidIDFormat = New(KindValidation, "invalid id format")
 
 	// Title.
-	ErrTitleRequired = errors.New("title is required")
-	ErrTitleTooLong  = errors.New("title too long")
+	ErrTitleRequired = New(KindValidation, "title is required")
+	ErrTitleTooLong  = New(KindValidation, "title too long")
 
 	// Category.
-	ErrCategoryRequired = errors.New("category is required")
+	ErrCategoryRequired = New(KindValidation, "category is required")
 
 	// Content.
-	ErrContentRequired = errors.New("content is required")
-	ErrContentTooLong  = errors.New("content too long")
+	ErrContentRequired = New(KindValidation, "content is required")
+	ErrContentTooLong  = New(KindValidation, "content too long")
 
 	// Name.
-	ErrNameRequired = errors.New("name is required")
-	ErrNameTooLong  = errors.New("name too long")
+	ErrNameRequired = New(KindValidation, "name is required")
+	ErrNameTooLong  = New(KindValidation, "name too long")
+
+	// Category tree.
+	ErrCategoryCycle    = New(KindValidation, "category cannot be its own ancestor")
+	ErrCategoryNotFound = New(KindNotFound, "category not found")
+	ErrUnknownCategory  = New(KindValidation, "category does not exist")
+	ErrCategoryInUse    = New(KindConflict, "category still has articles")
+
+	// User.
+	ErrEmailRequired    = New(KindValidation, "email is required")
+	ErrPasswordRequired = New(KindValidation, "password is required")
+	ErrPasswordTooShort = New(KindValidation, "password too short")
+	ErrPasswordTooLong  = New(KindValidation, "password too long")
+
+	// Email.
+	ErrEmailTooLong = New(KindValidation, "email too long")
+
+	// Auth.
+	ErrInvalidCredentials = New(KindUnauthenticated, "invalid email or password")
+	ErrUnauthenticated    = New(KindUnauthenticated, "authentication required")
+	ErrInvalidToken       = New(KindUnauthenticated, "invalid or expired token")
+	ErrAPIKeyRevoked      = New(KindUnauthenticated, "api key revoked")
+	ErrAPIKeyExpired      = New(KindUnauthenticated, "api key expired")
+	ErrAPIKeyNotFound     = New(KindNotFound, "api key not found")
+	ErrForbidden          = New(KindForbidden, "permission denied")
+	ErrInvalidRole        = New(KindValidation, "invalid role")
+
+	// Comment.
+	ErrArticleNotFound       = New(KindNotFound, "article not found")
+	ErrCommentNotFound       = New(KindNotFound, "comment not found")
+	ErrCommentParentMismatch = New(KindValidation, "parent comment belongs to another article")
+	ErrCommentsClosed        = New(KindConflict, "article is not open for comments")
+	ErrCommentParentHidden   = New(KindConflict, "parent comment is not approved")
 
 	// Tag.
-	ErrTagAlreadyExists = errors.New("tag already exists")
+	ErrTagAlreadyExists = New(KindConflict, "tag already exists")
+	ErrUnknownTag       = New(KindValidation, "tag does not exist")
+
+	// Status.
+	ErrInvalidStatus           = New(KindValidation, "invalid status")
+	ErrInvalidStatusTransition = New(KindConflict, "invalid status transition")
+	ErrScheduleInPast          = New(KindValidation, "scheduled time must be in the future")
 
 	// Slug.
-	ErrSlugRequired = errors.New("slug is required")
-	ErrSlugTooLong  = errors.New("slug too long")
+	ErrSlugRequired = New(KindValidation, "slug is required")
+	ErrSlugTooLong  = New(KindValidation, "slug too long")
+	ErrInvalidSlug  = New(KindValidation, "slug must be lowercase letters, digits and single hyphens")
+	ErrSlugTaken    = New(KindConflict, "slug is already taken")
 
 	// Color.
-	ErrColorRequired = errors.New("color is required")
+	ErrColorRequired = New(KindValidation, "color is required")
+
+	// Revision.
+	ErrRevisionRequired = New(KindValidation, "revision is required")
+	ErrRevisionMismatch = New(KindNotFound, "revision does not belong to article")
+	ErrDiffTooLarge     = New(KindValidation, "revisions are too large to compare")
+
+	// Trash.
+	ErrNotDeleted = New(KindNotFound, "entity is not in the trash")
+
+	// Concurrency.
+	ErrVersionConflict      = New(KindPrecondition, "entity was modified by another request")
+	ErrPreconditionRequired = New(KindPreconditionRequired, "If-Match header or version is required")
+
+	// Search.
+	ErrSearchQueryRequired = New(KindValidation, "search query is required")
+	ErrSearchQueryTooLong  = New(KindValidation, "search query too long")
+	ErrInvalidSearchQuery  = New(KindValidation, "search query has no searchable terms")
+
+	// Feed.
+	ErrInvalidFeedContent = New(KindValidation, "content must be full or summary")
 
 	// Limit.
-	ErrInvalidLimit  = errors.New("invalid limit")
-	ErrInvalidOffset = errors.New("invalid offset")
+	ErrInvalidLimit  = New(KindValidation, "invalid limit")
+	ErrInvalidOffset = New(KindValidation, "invalid offset")
+
+	// Cursor.
+	ErrInvalidCursor      = New(KindValidation, "invalid cursor")
+	ErrCursorSortMismatch = New(KindValidation, "cursor does not match order_by")
 
 	// OrderBy.
-	ErrInvalidOrderByField = errors.New("invalid order by field")
+	ErrInvalidOrderByField = New(KindValidation, "invalid order by field")
 )
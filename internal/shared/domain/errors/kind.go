@@ -0,0 +1,61 @@
+package errors
+
+import "errors"
+
+// Kind classifies a domain error by what the caller can do about it.
+type Kind int
+
+const (
+	// KindInternal covers failures the caller cannot fix, such as an
+	// unavailable database. Errors that carry no kind are internal.
+	KindInternal Kind = iota
+	// KindNotFound means the requested entity does not exist.
+	KindNotFound
+	// KindConflict means the request clashes with the current state, such
+	// as a duplicate unique value or a disallowed status transition.
+	KindConflict
+	// KindValidation means the request carries values the client has to
+	// change.
+	KindValidation
+	// KindPrecondition means a condition of the request, such as the
+	// expected version, no longer holds.
+	KindPrecondition
+	// KindPreconditionRequired means the request has to state a condition,
+	// such as the version it expects to change, before it is carried out.
+	KindPreconditionRequired
+	// KindUnauthenticated means the caller has to authenticate first.
+	KindUnauthenticated
+	// KindForbidden means the caller is not allowed to do this.
+	KindForbidden
+)
+
+// Error is a domain error of a known kind. Sentinels are *Error values, so
+// errors.Is keeps matching them through wrapping.
+type Error struct {
+	kind    Kind
+	message string
+}
+
+// New returns a domain error of the given kind.
+func New(kind Kind, message string) *Error {
+	return &Error{kind: kind, message: message}
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Kind returns the kind of the error.
+func (e *Error) Kind() Kind {
+	return e.kind
+}
+
+// KindOf returns the kind of the first domain error in err's chain, or
+// KindInternal when there is none.
+func KindOf(err error) Kind {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.kind
+	}
+	return KindInternal
+}
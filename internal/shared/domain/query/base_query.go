@@ -8,13 +8,29 @@ import (
 
 var ValidateQuery = validator.New()
 
+// DeletedScope selects which rows a listing returns with respect to soft
+// deletion.
+type DeletedScope string
+
+const (
+	// DeletedExclude hides soft-deleted rows. It is the default.
+	DeletedExclude DeletedScope = ""
+	// DeletedWith returns live and soft-deleted rows alike.
+	DeletedWith DeletedScope = "with"
+	// DeletedOnly returns soft-deleted rows only, i.e. the trash.
+	DeletedOnly DeletedScope = "only"
+)
+
 // BaseQuery base query struct.
 type BaseQuery struct {
-	IDs                 []uint   `binding:"omitempty"        json:"ids"                 validate:"omitempty,dive,gt=0"`
-	Limit               int      `binding:"omitempty, min=1" json:"limit"               validate:"omitempty,min=1"`
-	Offset              int      `binding:"omitempty, min=0" json:"offset"              validate:"omitempty,min=0"`
-	OrderBy             string   `binding:"omitempty"        json:"orderBy"             validate:"omitempty"`
-	PreloadAssociations []string `binding:"omitempty"        json:"preloadAssociations"`
+	IDs                 []uint       `binding:"omitempty"        json:"ids"                 validate:"omitempty,dive,gt=0"`
+	Limit               int          `binding:"omitempty, min=1" json:"limit"               validate:"omitempty,min=1"`
+	Offset              int          `binding:"omitempty, min=0" json:"offset"              validate:"omitempty,min=0"`
+	OrderBy             string       `binding:"omitempty"        json:"orderBy"             validate:"omitempty"`
+	PreloadAssociations []string     `binding:"omitempty"        json:"preloadAssociations"`
+	Cursor              *Cursor      `binding:"omitempty"        json:"cursor"`
+	SkipCount           bool         `binding:"omitempty"        json:"skipCount"`
+	Deleted             DeletedScope `binding:"omitempty"        json:"deleted"`
 }
 
 // NewBaseQuery create a new base query.
@@ -46,6 +62,32 @@ func (q *BaseQuery) WithOrderBy(orderBy string) *BaseQuery {
 	return q
 }
 
+// WithCursor switches to keyset pagination, continuing after cursor.
+// The offset is ignored while a cursor is set.
+func (q *BaseQuery) WithCursor(cursor *Cursor) *BaseQuery {
+	q.Cursor = cursor
+	q.Offset = 0
+	return q
+}
+
+// WithoutCount skips the total count query.
+func (q *BaseQuery) WithoutCount() *BaseQuery {
+	q.SkipCount = true
+	return q
+}
+
+// WithDeleted includes soft-deleted rows alongside live ones.
+func (q *BaseQuery) WithDeleted() *BaseQuery {
+	q.Deleted = DeletedWith
+	return q
+}
+
+// OnlyDeleted restricts the listing to soft-deleted rows.
+func (q *BaseQuery) OnlyDeleted() *BaseQuery {
+	q.Deleted = DeletedOnly
+	return q
+}
+
 // ValidateQuery validate the query parameters.
 func (q *BaseQuery) ValidateQuery(v any) error {
 	return ValidateQuery.Struct(v)
@@ -0,0 +1,116 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm/schema"
+
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+// Cursor marks a position in a keyset-paginated listing: the sort key and ID
+// of the last row on the previous page. Value is nil when the sort key was
+// NULL and is otherwise the key in its text form.
+type Cursor struct {
+	Field string  `json:"f"`
+	Desc  bool    `json:"d"`
+	Value *string `json:"v"`
+	ID    uint    `json:"i"`
+}
+
+// Encode returns the opaque token handed to clients.
+func (c *Cursor) Encode() (string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// DecodeCursor parses a token produced by Encode.
+func DecodeCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.Field == "" || c.ID == 0 {
+		return nil, errors.ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// ParseOrderBy splits an order_by value such as "-created_at" or
+// "created_at DESC" into its field and direction. An empty value sorts by id.
+func ParseOrderBy(orderBy string) (string, bool) {
+	if orderBy == "" {
+		return constants.DefaultOrderBy, false
+	}
+	desc := strings.HasPrefix(orderBy, "-") || strings.HasSuffix(orderBy, " DESC")
+	field := strings.TrimSuffix(strings.TrimPrefix(orderBy, "-"), " DESC")
+	return field, desc
+}
+
+var schemaCache = &sync.Map{}
+
+// NextCursor returns the token for the page after entities, or an empty
+// string when entities is the last page.
+func NextCursor[T any](entities []*T, q BaseQuery) (string, error) {
+	if q.Limit <= 0 || len(entities) < q.Limit {
+		return "", nil
+	}
+
+	s, err := schema.Parse(new(T), schemaCache, schema.NamingStrategy{})
+	if err != nil {
+		return "", fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	field, desc := ParseOrderBy(q.OrderBy)
+	sortField := s.LookUpField(field)
+	idField := s.PrioritizedPrimaryField
+	if sortField == nil || idField == nil {
+		return "", errors.ErrInvalidOrderByField
+	}
+
+	last := reflect.ValueOf(entities[len(entities)-1]).Elem()
+	ctx := context.Background()
+
+	id, ok := idField.ReflectValueOf(ctx, last).Interface().(uint)
+	if !ok {
+		return "", errors.ErrInvalidCursor
+	}
+
+	cursor := &Cursor{
+		Field: field,
+		Desc:  desc,
+		Value: cursorValue(sortField.ReflectValueOf(ctx, last)),
+		ID:    id,
+	}
+	return cursor.Encode()
+}
+
+func cursorValue(v reflect.Value) *string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	var text string
+	if t, ok := v.Interface().(time.Time); ok {
+		text = t.Format(time.RFC3339Nano)
+	} else {
+		text = fmt.Sprint(v.Interface())
+	}
+	return &text
+}
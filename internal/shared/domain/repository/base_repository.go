@@ -1,21 +1,47 @@
 package repository
 
-import "github.com/jambo0624/blog/internal/shared/domain/query"
+import (
+	"context"
+	"time"
+
+	"github.com/jambo0624/blog/internal/shared/domain/query"
+)
 
 // Entity represents a domain entity.
 type Entity interface {
 	GetID() uint
 }
 
+// Versioned is implemented by entities guarded by optimistic locking. The
+// version starts at 1 and increases with every successful update.
+type Versioned interface {
+	GetVersion() uint
+	SetVersion(version uint)
+}
+
+// Timestamped is implemented by entities that record when they last changed.
+type Timestamped interface {
+	GetUpdatedAt() time.Time
+}
+
+// Composite is implemented by entities whose representation embeds data kept
+// outside their own row, such as counters or associations, which changes
+// without moving their UpdatedAt.
+type Composite interface {
+	EmbedsDerivedData() bool
+}
+
 type Query interface {
 	GetBaseQuery() query.BaseQuery
 	Validate() error
 }
 
 type BaseRepository[T Entity, Q Query] interface {
-	Save(entity *T) error
-	FindByID(id uint, preloadAssociations ...string) (*T, error)
-	FindAll(query Q) ([]*T, int64, error)
-	Update(entity *T) error
-	Delete(id uint) error
+	Save(ctx context.Context, entity *T) error
+	FindByID(ctx context.Context, id uint, preloadAssociations ...string) (*T, error)
+	FindAll(ctx context.Context, query Q) ([]*T, int64, error)
+	Update(ctx context.Context, entity *T) error
+	Delete(ctx context.Context, id uint) error
+	Restore(ctx context.Context, id uint) error
+	Purge(ctx context.Context, id uint) error
 }
@@ -0,0 +1,23 @@
+package repository
+
+import "context"
+
+// UnitOfWork runs a group of repository operations atomically. Repositories
+// called with the context handed to fn take part in the same transaction,
+// which is rolled back if fn returns an error.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// DirectUnitOfWork runs fn without a transaction, for stores that have none.
+type DirectUnitOfWork struct{}
+
+// NewDirectUnitOfWork creates a unit of work that calls fn directly.
+func NewDirectUnitOfWork() UnitOfWork {
+	return DirectUnitOfWork{}
+}
+
+// Do calls fn with ctx unchanged.
+func (DirectUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
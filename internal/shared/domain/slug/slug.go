@@ -0,0 +1,102 @@
+// Package slug turns titles into URL path segments.
+package slug
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+)
+
+// Fallback is used when nothing of a title survives transliteration.
+const Fallback = "untitled"
+
+// pattern matches lowercase ASCII words joined by single hyphens.
+var pattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// transliterations spell out letters that do not decompose into an ASCII
+// base letter and a combining mark.
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'ł': "l", 'đ': "d", 'ð': "d", 'þ': "th", 'ı': "i",
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh",
+	'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "iu", 'я': "ia",
+	'є': "ie", 'і': "i", 'ї': "i", 'ґ': "g",
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i",
+	'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s",
+	'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// Make derives a slug from title: letters are transliterated to ASCII where
+// possible, everything else separates words, and the result is cut to
+// constants.MaxSlugLength at a word boundary. Titles without any usable
+// letters give Fallback.
+func Make(title string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range norm.NFKD.String(strings.ToLower(title)) {
+		var part string
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			part = string(r)
+		case unicode.Is(unicode.Mn, r):
+			// combining marks left over from decomposing accented letters
+			continue
+		default:
+			t, ok := transliterations[r]
+			if ok && t == "" {
+				// letters with no sound of their own, such as the hard and
+				// soft signs, vanish without splitting the word
+				continue
+			}
+			part = t
+		}
+
+		if part == "" {
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteString(part)
+	}
+
+	s := truncate(b.String(), constants.MaxSlugLength)
+	if s == "" {
+		return Fallback
+	}
+	return s
+}
+
+// WithSuffix numbers base to tell it apart from a taken slug, keeping the
+// result within constants.MaxSlugLength.
+func WithSuffix(base string, n int) string {
+	suffix := "-" + strconv.Itoa(n)
+	return truncate(base, constants.MaxSlugLength-len(suffix)) + suffix
+}
+
+// Valid reports whether s is a well-formed slug.
+func Valid(s string) bool {
+	return len(s) <= constants.MaxSlugLength && pattern.MatchString(s)
+}
+
+// truncate cuts s to at most limit bytes, preferring the last word boundary.
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	s = s[:limit]
+	if i := strings.LastIndexByte(s, '-'); i > 0 {
+		s = s[:i]
+	}
+	return strings.TrimRight(s, "-")
+}
@@ -12,4 +12,20 @@ var (
 // Config errors.
 var (
 	ErrFailedToReadConfig = errors.New("failed to read config")
+	ErrMissingJWTSecret   = errors.New("JWT_SECRET must be set unless GO_ENV is development or test")
+)
+
+// Migration errors.
+var (
+	ErrInvalidMigrationName = errors.New("invalid migration file name")
+	ErrDuplicateMigration   = errors.New("duplicate migration version")
+	ErrIncompleteMigration  = errors.New("migration needs both an up and a down file")
+	ErrChecksumMismatch     = errors.New("applied migration has been modified")
+	ErrUnknownMigration     = errors.New("applied migration is missing from the migrations directory")
+	ErrPendingMigration     = errors.New("migration has not been applied")
+)
+
+// Health errors.
+var (
+	ErrMissingDependency = errors.New("dependency not registered")
 )
@@ -0,0 +1,122 @@
+package migration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/jambo0624/blog/internal/shared/infrastructure/errors"
+)
+
+// fileName matches NNN_name.up.sql and NNN_name.down.sql.
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is a numbered pair of SQL scripts, Up applying a schema change
+// and Down reverting it.
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Checksum identifies the up script, so edits to migrations that already
+// ran are detected instead of silently diverging between databases.
+func (m Migration) Checksum() string {
+	sum := sha256.Sum256([]byte(m.Up))
+	return hex.EncodeToString(sum[:])
+}
+
+// Load reads the migrations at the root of fsys in version order. Files that
+// are not SQL are ignored.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("%w: %s", errors.ErrInvalidMigrationName, entry.Name())
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errors.ErrInvalidMigrationName, entry.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("%w: %d", errors.ErrDuplicateMigration, version)
+		}
+
+		script := &m.Up
+		if match[3] == "down" {
+			script = &m.Down
+		}
+		if *script != "" {
+			return nil, fmt.Errorf("%w: %d", errors.ErrDuplicateMigration, version)
+		}
+		*script = string(content)
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("%w: %03d_%s", errors.ErrIncompleteMigration, m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Create writes an empty up/down pair to dir, numbered after the highest
+// version already there, and returns the paths of both files.
+func Create(dir, name string) (string, string, error) {
+	base := fmt.Sprintf("%03d_%s", 1, name)
+	migrations, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+	if len(migrations) > 0 {
+		base = fmt.Sprintf("%03d_%s", migrations[len(migrations)-1].Version+1, name)
+	}
+	if !fileName.MatchString(base + ".up.sql") {
+		return "", "", fmt.Errorf("%w: %s", errors.ErrInvalidMigrationName, name)
+	}
+
+	up := filepath.Join(dir, base+".up.sql")
+	down := filepath.Join(dir, base+".down.sql")
+	if err := os.WriteFile(up, []byte("-- "+name+"\n"), 0o600); err != nil {
+		return "", "", fmt.Errorf("failed to create migration: %w", err)
+	}
+	if err := os.WriteFile(down, []byte("-- revert "+name+"\n"), 0o600); err != nil {
+		return "", "", fmt.Errorf("failed to create migration: %w", err)
+	}
+
+	return up, down, nil
+}
@@ -0,0 +1,294 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/shared/infrastructure/errors"
+)
+
+// lockKey identifies the advisory lock that serializes migration runs
+// across replicas starting at the same time.
+const lockKey int64 = 0x626c6f67_6d696772 // "blogmigr"
+
+// DefaultTable records which migrations have been applied.
+const DefaultTable = "schema_migrations"
+
+// State describes a migration relative to the database.
+type State string
+
+const (
+	StatePending  State = "pending"
+	StateApplied  State = "applied"
+	StateModified State = "modified" // applied, but the file changed since
+	StateUnknown  State = "unknown"  // applied, but the file is gone
+)
+
+// Status reports one migration for the status command.
+type Status struct {
+	Version   uint64
+	Name      string
+	State     State
+	AppliedAt *time.Time
+}
+
+// appliedMigration is a row of the migrations table.
+type appliedMigration struct {
+	Version   uint64    `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"size:255;not null"`
+	Checksum  string    `gorm:"size:64;not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+// Step is Go code a migration runs in its transaction right after the up
+// script, for data changes SQL cannot express.
+type Step func(tx *gorm.DB) error
+
+// Migrator applies and reverts migrations, one transaction per migration,
+// while holding a session advisory lock so concurrent runs wait their turn.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+	steps      map[uint64]Step
+	table      string
+}
+
+func NewMigrator(db *gorm.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		table:      DefaultTable,
+	}, nil
+}
+
+// WithTable records applied migrations in table instead of DefaultTable.
+func (m *Migrator) WithTable(table string) *Migrator {
+	m.table = table
+	return m
+}
+
+// WithSteps runs the given steps, keyed by migration version, after the up
+// scripts of their migrations.
+func (m *Migrator) WithSteps(steps map[uint64]Step) *Migrator {
+	m.steps = steps
+	return m
+}
+
+// Up applies every pending migration in version order and returns them.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB, done map[uint64]appliedMigration) error {
+		for _, migration := range m.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Up).Error; err != nil {
+					return err
+				}
+				if step, ok := m.steps[migration.Version]; ok {
+					if err := step(tx); err != nil {
+						return err
+					}
+				}
+				return tx.Table(m.table).Create(&appliedMigration{
+					Version:   migration.Version,
+					Name:      migration.Name,
+					Checksum:  migration.Checksum(),
+					AppliedAt: time.Now(),
+				}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("failed to apply migration %03d_%s: %w", migration.Version, migration.Name, err)
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down reverts the latest steps applied migrations, newest first, and
+// returns them.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB, done map[uint64]appliedMigration) error {
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Down).Error; err != nil {
+					return err
+				}
+				return tx.Table(m.table).Delete(&appliedMigration{}, migration.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("failed to revert migration %03d_%s: %w", migration.Version, migration.Name, err)
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status lists every known or applied migration in version order. Unlike Up
+// and Down it reports modified and unknown migrations instead of failing.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	done, err := m.applied(m.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	known := make(map[uint64]bool, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+		status := Status{Version: migration.Version, Name: migration.Name, State: StatePending}
+		if row, ok := done[migration.Version]; ok {
+			status.State = StateApplied
+			status.AppliedAt = &row.AppliedAt
+			if row.Checksum != migration.Checksum() {
+				status.State = StateModified
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	for _, row := range done {
+		if !known[row.Version] {
+			statuses = append(statuses, Status{
+				Version:   row.Version,
+				Name:      row.Name,
+				State:     StateUnknown,
+				AppliedAt: &row.AppliedAt,
+			})
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+	return statuses, nil
+}
+
+// Check reports whether the database is at the latest migration: it fails
+// when a migration is still pending or when the applied ones no longer match
+// the files. Migrations applied by a newer release are tolerated so the
+// previous one stays ready during a rolling deploy. It reads the migrations
+// table without taking the lock.
+func (m *Migrator) Check(ctx context.Context) error {
+	done, err := m.applied(m.db.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if err := m.verifyChecksums(done); err != nil {
+		return err
+	}
+
+	for _, migration := range m.migrations {
+		if _, ok := done[migration.Version]; !ok {
+			return fmt.Errorf("%w: %03d_%s", errors.ErrPendingMigration, migration.Version, migration.Name)
+		}
+	}
+	return nil
+}
+
+// withLock pins a single connection, takes the migration lock on it, checks
+// the applied migrations against the files and runs fn.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB, done map[uint64]appliedMigration) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		// Waiting for the lock and running migrations may take longer than
+		// the statement_timeout set up for serving requests.
+		if err := conn.Exec("SET statement_timeout = 0").Error; err != nil {
+			return fmt.Errorf("failed to lift statement timeout: %w", err)
+		}
+		defer conn.WithContext(context.Background()).Exec("RESET statement_timeout")
+
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", lockKey).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		// Unlock even when ctx is done, the connection goes back to the pool.
+		defer conn.WithContext(context.Background()).Exec("SELECT pg_advisory_unlock(?)", lockKey)
+
+		if err := m.ensureTable(conn); err != nil {
+			return err
+		}
+		done, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(done); err != nil {
+			return err
+		}
+
+		return fn(conn, done)
+	})
+}
+
+func (m *Migrator) ensureTable(conn *gorm.DB) error {
+	if err := conn.Table(m.table).AutoMigrate(&appliedMigration{}); err != nil {
+		return fmt.Errorf("failed to create %s: %w", m.table, err)
+	}
+	return nil
+}
+
+func (m *Migrator) applied(conn *gorm.DB) (map[uint64]appliedMigration, error) {
+	var rows []appliedMigration
+	if !conn.Migrator().HasTable(m.table) {
+		return map[uint64]appliedMigration{}, nil
+	}
+	if err := conn.Table(m.table).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", m.table, err)
+	}
+
+	done := make(map[uint64]appliedMigration, len(rows))
+	for _, row := range rows {
+		done[row.Version] = row
+	}
+	return done, nil
+}
+
+// verify refuses to go on when an applied migration was edited or removed,
+// as the database no longer matches what the files describe.
+func (m *Migrator) verify(done map[uint64]appliedMigration) error {
+	if err := m.verifyChecksums(done); err != nil {
+		return err
+	}
+
+	known := make(map[uint64]bool, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+	}
+	for _, row := range done {
+		if !known[row.Version] {
+			return fmt.Errorf("%w: %03d_%s", errors.ErrUnknownMigration, row.Version, row.Name)
+		}
+	}
+	return nil
+}
+
+// verifyChecksums refuses applied migrations whose file has been edited since.
+func (m *Migrator) verifyChecksums(done map[uint64]appliedMigration) error {
+	for _, migration := range m.migrations {
+		if row, ok := done[migration.Version]; ok && row.Checksum != migration.Checksum() {
+			return fmt.Errorf("%w: %03d_%s", errors.ErrChecksumMismatch, migration.Version, migration.Name)
+		}
+	}
+	return nil
+}
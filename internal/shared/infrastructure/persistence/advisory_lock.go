@@ -0,0 +1,34 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// AdvisoryLocker runs work under a Postgres advisory lock so that only one
+// replica executes it at a time.
+type AdvisoryLocker struct {
+	db *gorm.DB
+}
+
+func NewAdvisoryLocker(db *gorm.DB) *AdvisoryLocker {
+	return &AdvisoryLocker{db: db}
+}
+
+// TryWithLock runs fn if the lock identified by key can be acquired without
+// waiting. It reports whether the lock was acquired. The lock is transaction
+// scoped, so it is released as soon as fn returns, even if the process dies.
+func (l *AdvisoryLocker) TryWithLock(ctx context.Context, key int64, fn func() error) (bool, error) {
+	acquired := false
+	err := l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", key).Scan(&acquired).Error; err != nil {
+			return err
+		}
+		if !acquired {
+			return nil
+		}
+		return fn()
+	})
+	return acquired, err
+}
@@ -1,10 +1,11 @@
 package persistence
 
 import (
-	"time"
+	"context"
 
 	"gorm.io/gorm"
 
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
 	"github.com/jambo0624/blog/internal/shared/domain/repository"
 	infraErrors "github.com/jambo0624/blog/internal/shared/infrastructure/errors"
 )
@@ -23,13 +24,13 @@ func NewBaseGormRepository[T repository.Entity, Q repository.Query](db *gorm.DB)
 	return &BaseGormRepository[T, Q]{db: db}
 }
 
-func (r *BaseGormRepository[T, Q]) Save(entity *T) error {
-	return r.db.Create(entity).Error
+func (r *BaseGormRepository[T, Q]) Save(ctx context.Context, entity *T) error {
+	return TranslateError(Conn(ctx, r.db).Create(entity).Error)
 }
 
-func (r *BaseGormRepository[T, Q]) FindByID(id uint, preloadAssociations ...string) (*T, error) {
+func (r *BaseGormRepository[T, Q]) FindByID(ctx context.Context, id uint, preloadAssociations ...string) (*T, error) {
 	var entity T
-	query := r.db.Model(new(T))
+	query := Conn(ctx, r.db).Model(new(T))
 	if len(preloadAssociations) > 0 {
 		for _, preload := range preloadAssociations {
 			query = query.Preload(preload)
@@ -37,17 +38,17 @@ func (r *BaseGormRepository[T, Q]) FindByID(id uint, preloadAssociations ...stri
 	}
 	err := query.First(&entity, id).Error
 	if err != nil {
-		return nil, err
+		return nil, TranslateError(err)
 	}
 	return &entity, nil
 }
 
-func (r *BaseGormRepository[T, Q]) FindAll(q Q) ([]*T, int64, error) {
+func (r *BaseGormRepository[T, Q]) FindAll(ctx context.Context, q Q) ([]*T, int64, error) {
 	var entities []*T
 	var total int64
 
 	// Build base query
-	query := r.db.Model(new(T))
+	query := Conn(ctx, r.db).Model(new(T))
 	// Check if the query implements the QueryFilter interface
 	filterer, ok := any(q).(QueryFilter)
 	if !ok {
@@ -57,28 +58,38 @@ func (r *BaseGormRepository[T, Q]) FindAll(q Q) ([]*T, int64, error) {
 	// Apply filters (to be implemented by child repositories)
 	query = filterer.ApplyFilters(query)
 
-	// Get total count
-	if err := query.Count(&total).Error; err != nil {
+	baseQuery := q.GetBaseQuery()
+	table, err := r.tableName()
+	if err != nil {
 		return nil, 0, err
 	}
+	query = applyDeletedScope(query, table, baseQuery.Deleted)
+
+	// Get total count
+	if !baseQuery.SkipCount {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+	}
 
 	// Apply preloads
 	for _, preload := range filterer.GetPreloadAssociations() {
 		query = query.Preload(preload)
 	}
 
-	baseQuery := q.GetBaseQuery()
+	// Apply keyset position and sorting
+	if baseQuery.Cursor != nil {
+		query = applyCursor(query, table, baseQuery.Cursor)
+	}
+	query = applyOrder(query, table, baseQuery.OrderBy)
 
-	// Apply pagination and sorting
+	// Apply pagination
 	if baseQuery.Limit > 0 {
 		query = query.Limit(baseQuery.Limit)
 	}
-	if baseQuery.Offset > 0 {
+	if baseQuery.Offset > 0 && baseQuery.Cursor == nil {
 		query = query.Offset(baseQuery.Offset)
 	}
-	if baseQuery.OrderBy != "" {
-		query = query.Order(baseQuery.OrderBy)
-	}
 
 	// Get results
 	if err := query.Find(&entities).Error; err != nil {
@@ -88,11 +99,31 @@ func (r *BaseGormRepository[T, Q]) FindAll(q Q) ([]*T, int64, error) {
 	return entities, total, nil
 }
 
-func (r *BaseGormRepository[T, Q]) Update(entity *T) error {
-	return r.db.Save(entity).Error
+// Update saves entity. Versioned entities are only written when the stored
+// version still matches the one they were loaded with, and move on to the next
+// version; a concurrent write in between yields ErrVersionConflict.
+func (r *BaseGormRepository[T, Q]) Update(ctx context.Context, entity *T) error {
+	versioned, ok := any(entity).(repository.Versioned)
+	if !ok {
+		return TranslateError(Conn(ctx, r.db).Save(entity).Error)
+	}
+
+	current := versioned.GetVersion()
+	versioned.SetVersion(current + 1)
+
+	result := Conn(ctx, r.db).Select("*").Where("version = ?", current).Save(entity)
+	if result.Error != nil {
+		versioned.SetVersion(current)
+		return TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		versioned.SetVersion(current)
+		return domainErrors.ErrVersionConflict
+	}
+	return nil
 }
 
 // Delete implements soft delete.
-func (r *BaseGormRepository[T, Q]) Delete(id uint) error {
-	return r.db.Model(new(T)).Where("id = ?", id).Update("deleted_at", time.Now()).Error
+func (r *BaseGormRepository[T, Q]) Delete(ctx context.Context, id uint) error {
+	return Conn(ctx, r.db).Delete(new(T), id).Error
 }
@@ -1,34 +1,100 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
-	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
-	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
+	"github.com/jambo0624/blog/database/migrations"
 	config "github.com/jambo0624/blog/internal/shared/infrastructure/config"
-	tagEntity "github.com/jambo0624/blog/internal/tag/domain/entity"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/migration"
 )
 
 func InitDB(cfg *config.Config) (*gorm.DB, error) {
-	db, err := gorm.Open(postgres.Open(cfg.Database.URL), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(DSN(cfg.Database)), &gorm.Config{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	// only auto migrate in non-production environment
+	// apply pending migrations in non-production environment, production
+	// runs cmd/migrate as a separate deployment step
 	if cfg.Environment != "production" {
-		err = db.AutoMigrate(
-			&articleEntity.Article{},
-			&categoryEntity.Category{},
-			&tagEntity.Tag{},
-		)
+		migrator, err := newMigrator(db)
 		if err != nil {
-			return nil, fmt.Errorf("failed to auto migrate: %w", err)
+			return nil, err
+		}
+		if _, err := migrator.Up(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to migrate: %w", err)
 		}
 	}
 
 	return db, nil
 }
+
+// DSN returns the connection URL of cfg, asking Postgres to cancel any
+// statement that runs longer than cfg.StatementTimeout. The setting applies
+// to each statement on its own, unlike a deadline on the request context.
+func DSN(cfg config.DatabaseConfig) string {
+	if cfg.StatementTimeout <= 0 {
+		return cfg.URL
+	}
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return cfg.URL
+	}
+
+	params := u.Query()
+	if !params.Has("statement_timeout") {
+		params.Set("statement_timeout", strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10))
+	}
+	u.RawQuery = params.Encode()
+	return u.String()
+}
+
+// CloseDB closes the connection pool behind db, waiting for queries in
+// progress to finish.
+func CloseDB(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database pool: %w", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		return fmt.Errorf("failed to close database: %w", err)
+	}
+	return nil
+}
+
+// PingDB checks that the database answers within ctx.
+func PingDB(ctx context.Context, db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database pool: %w", err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+	return nil
+}
+
+// CheckMigrations checks that every embedded migration has been applied
+// and none of them changed since.
+func CheckMigrations(ctx context.Context, db *gorm.DB) error {
+	migrator, err := newMigrator(db)
+	if err != nil {
+		return err
+	}
+	return migrator.Check(ctx)
+}
+
+// newMigrator loads the embedded migrations together with their Go steps.
+func newMigrator(db *gorm.DB) (*migration.Migrator, error) {
+	migrator, err := migration.NewMigrator(db, migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+	return migrator.WithSteps(migrations.Steps), nil
+}
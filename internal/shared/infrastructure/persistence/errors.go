@@ -0,0 +1,42 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+// Postgres SQLSTATEs for constraint violations.
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// TranslateError wraps database errors the domain has a name for: missing
+// records become ErrNotFound, unique constraint violations ErrDuplicate and
+// foreign key violations ErrReferenced.
+// The original error stays in the chain, other errors are returned as is.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: %w", domainErrors.ErrNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	isPgErr := errors.As(err, &pgErr)
+	if errors.Is(err, gorm.ErrDuplicatedKey) || (isPgErr && pgErr.Code == uniqueViolation) {
+		return fmt.Errorf("%w: %w", domainErrors.ErrDuplicate, err)
+	}
+	if errors.Is(err, gorm.ErrForeignKeyViolated) || (isPgErr && pgErr.Code == foreignKeyViolation) {
+		return fmt.Errorf("%w: %w", domainErrors.ErrReferenced, err)
+	}
+
+	return err
+}
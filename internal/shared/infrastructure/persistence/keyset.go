@@ -0,0 +1,68 @@
+package persistence
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/shared/domain/query"
+)
+
+// tableName resolves the table backing T so sort columns can be qualified
+// when filters join other tables.
+func (r *BaseGormRepository[T, Q]) tableName() (string, error) {
+	stmt := &gorm.Statement{DB: r.db}
+	if err := stmt.Parse(new(T)); err != nil {
+		return "", fmt.Errorf("failed to parse model: %w", err)
+	}
+	return stmt.Schema.Table, nil
+}
+
+// applyOrder sorts by orderBy with id as a tie-breaker, so that rows sharing
+// a sort key keep a stable order across pages.
+func applyOrder(db *gorm.DB, table, orderBy string) *gorm.DB {
+	field, desc := query.ParseOrderBy(orderBy)
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+
+	db = db.Order(fmt.Sprintf("%s.%s %s", table, field, direction))
+	if field != "id" {
+		db = db.Order(fmt.Sprintf("%s.id %s", table, direction))
+	}
+	return db
+}
+
+// applyCursor restricts db to rows after cursor in (field, id) order.
+// Postgres sorts NULLs last ascending and first descending, which the
+// NULL branches mirror. cursor.Field has already been checked against the
+// handler's allowed order_by fields.
+func applyCursor(db *gorm.DB, table string, cursor *query.Cursor) *gorm.DB {
+	column := fmt.Sprintf("%s.%s", table, cursor.Field)
+	id := fmt.Sprintf("%s.id", table)
+
+	if cursor.Field == "id" {
+		if cursor.Desc {
+			return db.Where(id+" < ?", cursor.ID)
+		}
+		return db.Where(id+" > ?", cursor.ID)
+	}
+
+	switch {
+	case !cursor.Desc && cursor.Value != nil:
+		return db.Where(
+			fmt.Sprintf("(%[1]s > ? OR (%[1]s = ? AND %[2]s > ?) OR %[1]s IS NULL)", column, id),
+			*cursor.Value, *cursor.Value, cursor.ID,
+		)
+	case !cursor.Desc:
+		return db.Where(fmt.Sprintf("(%s IS NULL AND %s > ?)", column, id), cursor.ID)
+	case cursor.Value != nil:
+		return db.Where(
+			fmt.Sprintf("(%[1]s < ? OR (%[1]s = ? AND %[2]s < ?))", column, id),
+			*cursor.Value, *cursor.Value, cursor.ID,
+		)
+	default:
+		return db.Where(fmt.Sprintf("(%s IS NOT NULL OR %s < ?)", column, id), cursor.ID)
+	}
+}
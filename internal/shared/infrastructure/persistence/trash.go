@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/domain/query"
+)
+
+// applyDeletedScope widens or narrows the default soft-delete filter.
+func applyDeletedScope(db *gorm.DB, table string, scope query.DeletedScope) *gorm.DB {
+	switch scope {
+	case query.DeletedWith:
+		return db.Unscoped()
+	case query.DeletedOnly:
+		return db.Unscoped().Where(table + ".deleted_at IS NOT NULL")
+	default:
+		return db
+	}
+}
+
+// Restore moves a soft-deleted entity out of the trash.
+func (r *BaseGormRepository[T, Q]) Restore(ctx context.Context, id uint) error {
+	result := Conn(ctx, r.db).Unscoped().Model(new(T)).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrNotDeleted
+	}
+	return nil
+}
+
+// Purge permanently removes a soft-deleted entity.
+func (r *BaseGormRepository[T, Q]) Purge(ctx context.Context, id uint) error {
+	return r.PurgeWith(ctx, id, nil)
+}
+
+// PurgeWith permanently removes a soft-deleted entity, running cleanup in the
+// same transaction first so repositories can drop rows that reference it.
+// Live entities must be deleted before they can be purged; rows that still
+// reference the entity afterwards yield ErrReferenced.
+func (r *BaseGormRepository[T, Q]) PurgeWith(ctx context.Context, id uint, cleanup func(tx *gorm.DB) error) error {
+	err := Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var entity T
+		err := tx.Unscoped().
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("deleted_at IS NOT NULL").
+			First(&entity, id).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainErrors.ErrNotDeleted
+		}
+		if err != nil {
+			return err
+		}
+
+		if cleanup != nil {
+			if err := cleanup(tx); err != nil {
+				return err
+			}
+		}
+
+		return tx.Unscoped().Delete(new(T), id).Error
+	})
+	return TranslateError(err)
+}
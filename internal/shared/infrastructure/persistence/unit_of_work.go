@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/shared/domain/repository"
+)
+
+type txKey struct{}
+
+// GormUnitOfWork runs work in a database transaction carried by the context.
+type GormUnitOfWork struct {
+	db *gorm.DB
+}
+
+func NewGormUnitOfWork(db *gorm.DB) repository.UnitOfWork {
+	return &GormUnitOfWork{db: db}
+}
+
+// Do runs fn in a transaction. Calls nested in an active transaction join it
+// rather than opening a new one, so the outermost caller decides the commit.
+func (u *GormUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn returns the transaction active in ctx, or db bound to ctx when there
+// is none. Repositories use it for every statement so they join units of work.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return db.WithContext(ctx)
+}
@@ -1,18 +1,42 @@
 package http
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/jambo0624/blog/internal/shared/application/service"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	domainQuery "github.com/jambo0624/blog/internal/shared/domain/query"
 	"github.com/jambo0624/blog/internal/shared/domain/repository"
 	"github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
 	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
 )
 
 // EntityService interface for create/update operations.
 type EntityService[T repository.Entity, Q repository.Query, C dto.RequestDTO, U dto.RequestDTO] interface {
-	Create(req *C) (*T, error)
-	Update(id uint, req *U) (*T, error)
+	Create(ctx context.Context, req *C) (*T, error)
+	Update(ctx context.Context, id uint, req *U) (*T, error)
+}
+
+// Authorizer is implemented by entity services that guard their actions.
+// id is 0 when the action does not target an existing entity.
+type Authorizer interface {
+	Authorize(ctx context.Context, principal *auth.Principal, action auth.Action, id uint) error
+}
+
+// QueryAuthorizer is implemented by entity services whose listings need
+// more than the read permission for some queries, such as unpublished
+// articles.
+type QueryAuthorizer[Q repository.Query] interface {
+	AuthorizeQuery(ctx context.Context, principal *auth.Principal, query Q) error
+}
+
+// Presenter is implemented by entity services that fill in derived fields,
+// such as rendered content, before entities are written to a response.
+type Presenter[T repository.Entity] interface {
+	Present(entities ...*T)
 }
 
 type BaseHandler[T repository.Entity, Q repository.Query, C dto.RequestDTO, U dto.RequestDTO] struct {
@@ -30,76 +54,192 @@ func NewBaseHandler[T repository.Entity, Q repository.Query, C dto.RequestDTO, U
 	}
 }
 
+// Authorize checks the request's principal against the entity service's
+// policy and writes the error response when the action is not allowed.
+func (h *BaseHandler[T, Q, C, U]) Authorize(c *gin.Context, action auth.Action, id uint) bool {
+	authorizer, ok := h.EntityService.(Authorizer)
+	if !ok {
+		return true
+	}
+
+	principal, _ := middleware.PrincipalFrom(c)
+	if err := authorizer.Authorize(c.Request.Context(), principal, action, id); err != nil {
+		response.HandleError(c, err)
+		return false
+	}
+	return true
+}
+
+// AuthorizeQuery checks the request's principal against what the query asks
+// for and writes the error response when the listing is not allowed.
+func (h *BaseHandler[T, Q, C, U]) AuthorizeQuery(c *gin.Context, query Q) bool {
+	authorizer, ok := h.EntityService.(QueryAuthorizer[Q])
+	if !ok {
+		return true
+	}
+
+	principal, _ := middleware.PrincipalFrom(c)
+	if err := authorizer.AuthorizeQuery(c.Request.Context(), principal, query); err != nil {
+		response.HandleError(c, err)
+		return false
+	}
+	return true
+}
+
+// Present lets the entity service fill in the derived fields of entities
+// about to be written to the response.
+func (h *BaseHandler[T, Q, C, U]) Present(entities ...*T) {
+	if presenter, ok := h.EntityService.(Presenter[T]); ok {
+		presenter.Present(entities...)
+	}
+}
+
 // Create handles POST / requests.
 func (h *BaseHandler[T, Q, C, U]) Create(c *gin.Context) {
+	if !h.Authorize(c, auth.ActionCreate, 0) {
+		return
+	}
+
 	var req C
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, err)
+	if !BindJSON(c, &req) {
 		return
 	}
 
-	entity, err := h.EntityService.Create(&req)
+	entity, err := h.EntityService.Create(c.Request.Context(), &req)
 	if err != nil {
-		response.InternalError(c, err)
+		response.HandleError(c, err)
 		return
 	}
 
+	h.Present(entity)
 	response.Created(c, entity)
 }
 
 // Update handles PUT /:id requests.
 func (h *BaseHandler[T, Q, C, U]) Update(c *gin.Context) {
 	id := ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionUpdate, id) {
+		return
+	}
 
 	var req U
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, err)
+	if !BindJSON(c, &req) {
+		return
+	}
+	if !ApplyIfMatch(c, &req) {
 		return
 	}
 
-	entity, err := h.EntityService.Update(id, &req)
+	entity, err := h.EntityService.Update(c.Request.Context(), id, &req)
 	if err != nil {
-		response.InternalError(c, err)
+		response.HandleError(c, err)
 		return
 	}
 
+	h.Present(entity)
+	SetETag(c, entity)
 	response.Success(c, entity)
 }
 
-// FindByID handles GET /:id requests.
+// FindByID handles GET /:id requests, answering conditional requests with 304.
 func (h *BaseHandler[T, Q, C, U]) FindByID(c *gin.Context) {
 	id := ParseUintParam(c, "id")
-	entity, err := h.Service.FindByID(id)
+	if !h.Authorize(c, auth.ActionRead, id) {
+		return
+	}
+
+	entity, err := h.Service.FindByID(c.Request.Context(), id)
 	if err != nil {
-		response.NotFound(c)
+		response.HandleError(c, err)
 		return
 	}
-	response.Success(c, entity)
+	h.Present(entity)
+	RespondEntity(c, entity)
 }
 
 // FindAll handles GET / requests with query parameters.
 func (h *BaseHandler[T, Q, C, U]) FindAll(c *gin.Context, buildQuery func(*gin.Context) (Q, error)) {
+	if !h.Authorize(c, auth.ActionRead, 0) {
+		return
+	}
+
 	query, err := buildQuery(c)
 	if err != nil {
 		response.BadRequest(c, err)
 		return
 	}
 
-	entities, total, err := h.Service.FindAll(query)
+	// Browsing the trash takes the same permission as restoring from it.
+	if query.GetBaseQuery().Deleted != domainQuery.DeletedExclude && !h.Authorize(c, auth.ActionRestore, 0) {
+		return
+	}
+	if !h.AuthorizeQuery(c, query) {
+		return
+	}
+
+	entities, total, err := h.Service.FindAll(c.Request.Context(), query)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	baseQuery := query.GetBaseQuery()
+	nextCursor, err := domainQuery.NextCursor(entities, baseQuery)
 	if err != nil {
 		response.InternalError(c, err)
 		return
 	}
 
-	meta := response.NewMetaFromQuery(total, query.GetBaseQuery())
-	response.SuccessWithMeta(c, entities, *meta)
+	meta := response.NewMetaFromQuery(total, baseQuery).WithNextCursor(nextCursor)
+	h.Present(entities...)
+	RespondList(c, entities, *meta)
 }
 
 // Delete handles DELETE /:id requests.
 func (h *BaseHandler[T, Q, C, U]) Delete(c *gin.Context) {
 	id := ParseUintParam(c, "id")
-	if err := h.Service.Delete(id); err != nil {
-		response.InternalError(c, err)
+	if !h.Authorize(c, auth.ActionDelete, id) {
+		return
+	}
+
+	if err := h.Service.Delete(c.Request.Context(), id); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	response.NoContent(c)
+}
+
+// Restore handles POST /:id/restore requests.
+func (h *BaseHandler[T, Q, C, U]) Restore(c *gin.Context) {
+	id := ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionRestore, id) {
+		return
+	}
+
+	if err := h.Service.Restore(c.Request.Context(), id); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	entity, err := h.Service.FindByID(c.Request.Context(), id)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+	h.Present(entity)
+	response.Success(c, entity)
+}
+
+// Purge handles DELETE /:id/purge requests, permanently removing an entity
+// that is already in the trash.
+func (h *BaseHandler[T, Q, C, U]) Purge(c *gin.Context) {
+	id := ParseUintParam(c, "id")
+	if !h.Authorize(c, auth.ActionPurge, id) {
+		return
+	}
+
+	if err := h.Service.Purge(c.Request.Context(), id); err != nil {
+		response.HandleError(c, err)
 		return
 	}
 	response.NoContent(c)
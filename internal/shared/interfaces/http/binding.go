@@ -0,0 +1,124 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+)
+
+// Report binding failures under the JSON names clients send rather than the
+// Go field names.
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(jsonFieldName)
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// BindJSON decodes the request body into req, then checks its binding tags
+// and, for a RequestDTO, its business rules. Every rejected field is reported
+// together in a 422 response; a body that cannot be decoded at all answers
+// 400. It returns false when a response was written.
+func BindJSON(c *gin.Context, req any) bool {
+	var fieldErrs dto.ValidationErrors
+
+	err := c.ShouldBindJSON(req)
+	var bindErrs validator.ValidationErrors
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+	case errors.As(err, &bindErrs):
+		for _, bindErr := range bindErrs {
+			fieldErrs.Add(bindErr.Field(), bindErr.Tag(), bindingMessage(bindErr))
+		}
+	case errors.As(err, &typeErr):
+		response.ValidationError(c, dto.ValidationErrors{{
+			Field:   typeErr.Field,
+			Rule:    "type",
+			Message: "must be " + jsonTypeName(typeErr.Type),
+		}})
+		return false
+	default:
+		response.BadRequest(c, err)
+		return false
+	}
+
+	if validatable, ok := req.(dto.RequestDTO); ok {
+		var ruleErrs dto.ValidationErrors
+		if err := validatable.Validate(); errors.As(err, &ruleErrs) {
+			fieldErrs = append(fieldErrs, ruleErrs...)
+		} else if err != nil {
+			response.HandleError(c, err)
+			return false
+		}
+	}
+
+	if len(fieldErrs) > 0 {
+		response.ValidationError(c, fieldErrs)
+		return false
+	}
+	return true
+}
+
+// bindingMessage describes a failed validator tag for clients.
+func bindingMessage(fieldErr validator.FieldError) string {
+	unit := ""
+	if fieldErr.Kind() == reflect.String {
+		unit = " characters"
+	}
+
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		return fmt.Sprintf("must be at least %s%s", fieldErr.Param(), unit)
+	case "max":
+		return fmt.Sprintf("must be at most %s%s", fieldErr.Param(), unit)
+	case "gt":
+		return "must be greater than " + fieldErr.Param()
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fieldErr.Param(), " ", ", ")
+	case "email":
+		return "must be an email address"
+	case "url":
+		return "must be a URL"
+	case "hexcolor":
+		return "must be a hex color"
+	default:
+		return "failed the " + fieldErr.Tag() + " rule"
+	}
+}
+
+// jsonTypeName names the JSON type that decodes into t.
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/domain/repository"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+)
+
+// RespondEntity writes a single entity together with its ETag and
+// Last-Modified validators, or a bare 304 when the client's copy is current.
+// Entities that embed derived data are only validated by their ETag, as
+// their UpdatedAt misses changes to what they embed.
+func RespondEntity(c *gin.Context, entity any) {
+	SetETag(c, entity)
+	modified := lastModified(entity)
+	setLastModified(c, modified)
+
+	if composite, ok := entity.(repository.Composite); ok && composite.EmbedsDerivedData() {
+		modified = time.Time{}
+	}
+	if notModified(c, modified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	response.Success(c, entity)
+}
+
+// RespondList writes a page of entities like RespondEntity, validated by
+// ListValidators over the entities and the metadata.
+func RespondList[T repository.Entity](c *gin.Context, entities []*T, meta response.Meta) {
+	etag, modified := ListValidators(entities, meta)
+	if NotModified(c, etag, modified) {
+		return
+	}
+	response.SuccessWithMeta(c, entities, meta)
+}
+
+// ListValidators returns a weak ETag hashing the serialized entities and
+// extra, which should hold whatever else shapes the representation, and the
+// latest modification time among the entities.
+func ListValidators[T repository.Entity](entities []*T, extra any) (string, time.Time) {
+	var modified time.Time
+	for _, entity := range entities {
+		if updatedAt := lastModified(entity); updatedAt.After(modified) {
+			modified = updatedAt
+		}
+	}
+
+	hash := sha256.New()
+	if encoded, err := json.Marshal(extra); err == nil {
+		hash.Write(encoded)
+	}
+	if encoded, err := json.Marshal(entities); err == nil {
+		hash.Write(encoded)
+	}
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`, modified
+}
+
+// NotModified sets the ETag and Last-Modified validators of a list and, when
+// the client's copy is still current, writes a bare 304 and returns true.
+// Only the ETag decides: the latest modification time of a list misses
+// deletions and changes to derived data.
+func NotModified(c *gin.Context, etag string, modified time.Time) bool {
+	c.Header("ETag", etag)
+	setLastModified(c, modified)
+
+	if notModified(c, time.Time{}) {
+		c.Status(http.StatusNotModified)
+		return true
+	}
+	return false
+}
+
+func lastModified(entity any) time.Time {
+	if timestamped, ok := entity.(repository.Timestamped); ok {
+		return timestamped.GetUpdatedAt()
+	}
+	return time.Time{}
+}
+
+func setLastModified(c *gin.Context, modified time.Time) {
+	if !modified.IsZero() {
+		c.Header("Last-Modified", modified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// notModified evaluates If-None-Match, falling back to If-Modified-Since
+// only when the former is absent, as RFC 9110 requires, and modified is set.
+func notModified(c *gin.Context, modified time.Time) bool {
+	if header := c.GetHeader("If-None-Match"); header != "" {
+		etag := c.Writer.Header().Get("ETag")
+		return etag != "" && etagListMatches(header, etag)
+	}
+
+	header := c.GetHeader("If-Modified-Since")
+	if header == "" || modified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(header)
+	if err != nil {
+		return false
+	}
+	// HTTP dates have second precision.
+	return !modified.Truncate(time.Second).After(since)
+}
+
+// etagListMatches compares a comma separated If-None-Match list against etag
+// using the weak comparison function.
+func etagListMatches(list, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
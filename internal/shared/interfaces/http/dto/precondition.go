@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+// Conditional is implemented by requests that honour the If-Match header.
+type Conditional interface {
+	SetIfMatch(version uint)
+	HasIfMatch() bool
+}
+
+// Precondition carries the entity version a client expects to change. It is
+// read from the version field of the body, and If-Match takes precedence over
+// it. A nil IfMatch skips the check.
+type Precondition struct {
+	IfMatch *uint `json:"version,omitempty"`
+}
+
+// SetIfMatch records the version the client last saw, implement Conditional interface.
+func (p *Precondition) SetIfMatch(version uint) {
+	p.IfMatch = &version
+}
+
+// HasIfMatch reports whether the request states the version it expects,
+// implement Conditional interface.
+func (p *Precondition) HasIfMatch() bool {
+	return p.IfMatch != nil
+}
+
+// CheckVersion reports ErrVersionConflict when current is not the expected version.
+func (p *Precondition) CheckVersion(current uint) error {
+	if p.IfMatch != nil && *p.IfMatch != current {
+		return errors.ErrVersionConflict
+	}
+	return nil
+}
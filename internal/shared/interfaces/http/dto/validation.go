@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	"github.com/jambo0624/blog/internal/shared/domain/slug"
+)
+
+// Rules reported by the business rule checks, named like the validator tags
+// they complement.
+const (
+	RuleMin      = "min"
+	RuleMax      = "max"
+	RuleSlug     = "slug"
+	RuleHexColor = "hexcolor"
+)
+
+var hexColor = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+// FieldError describes why one request field was rejected. Field is the
+// field's JSON name.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// ValidationErrors collects the field errors of a request.
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	parts := make([]string, len(e))
+	for i, fieldErr := range e {
+		parts[i] = fieldErr.Field + ": " + fieldErr.Message
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Add records that field broke rule.
+func (e *ValidationErrors) Add(field, rule, message string) {
+	*e = append(*e, FieldError{Field: field, Rule: rule, Message: message})
+}
+
+// Err returns the collected errors, or nil when there are none.
+func (e ValidationErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// CheckLength records an error when value has fewer than minimum or more
+// than maximum characters. Empty values are left to the required tag.
+func (e *ValidationErrors) CheckLength(field, value string, minimum, maximum int) {
+	if value == "" {
+		return
+	}
+	switch length := utf8.RuneCountInString(value); {
+	case length < minimum:
+		e.Add(field, RuleMin, fmt.Sprintf("must be at least %d characters", minimum))
+	case length > maximum:
+		e.Add(field, RuleMax, fmt.Sprintf("must be at most %d characters", maximum))
+	}
+}
+
+// CheckSlug records an error when value is set and is not a valid slug.
+func (e *ValidationErrors) CheckSlug(field, value string) {
+	switch {
+	case value == "":
+	case len(value) > constants.MaxSlugLength:
+		e.Add(field, RuleMax, fmt.Sprintf("must be at most %d characters", constants.MaxSlugLength))
+	case !slug.Valid(value):
+		e.Add(field, RuleSlug, "must be lowercase letters, digits and single hyphens")
+	}
+}
+
+// CheckHexColor records an error when value is set and is not a hex color
+// such as #1e90ff.
+func (e *ValidationErrors) CheckHexColor(field, value string) {
+	if value != "" && !hexColor.MatchString(value) {
+		e.Add(field, RuleHexColor, "must be a hex color")
+	}
+}
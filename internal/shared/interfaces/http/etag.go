@@ -0,0 +1,85 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/domain/repository"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+)
+
+// SetETag sets the weak ETag of a single entity, see FormatETag.
+func SetETag(c *gin.Context, entity any) {
+	var version uint
+	if versioned, ok := entity.(repository.Versioned); ok {
+		version = versioned.GetVersion()
+	}
+	c.Header("ETag", FormatETag(version, entity))
+}
+
+// FormatETag renders a weak entity tag made of the entity version and a hash
+// of its serialized representation. The hash moves whenever the response body
+// does, such as a new comment count or a renamed category, even though the
+// version only counts writes to the entity itself.
+func FormatETag(version uint, representation any) string {
+	hash := sha256.New()
+	if encoded, err := json.Marshal(representation); err == nil {
+		hash.Write(encoded)
+	}
+	return `W/"` + strconv.FormatUint(uint64(version), 10) + "-" + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// ParseETag reads the version back from an entity tag issued by FormatETag.
+// A bare quoted version is accepted as well.
+func ParseETag(tag string) (uint, bool) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+	value, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+	version, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint(version), true
+}
+
+// ApplyIfMatch copies the If-Match header onto a conditional request. Only
+// the version part of the tag is compared: the write conflicts with other
+// writes to the entity, not with changes to what is derived from it. Without
+// the header the request must carry the version in its body, else a 428
+// response is written; "*" leaves the request unconditional. It writes a 412
+// response and returns false when the header is not an ETag this API issued.
+func ApplyIfMatch(c *gin.Context, req any) bool {
+	conditional, ok := req.(dto.Conditional)
+	if !ok {
+		return true
+	}
+
+	header := c.GetHeader("If-Match")
+	switch header {
+	case "*":
+		return true
+	case "":
+		if !conditional.HasIfMatch() {
+			response.PreconditionRequired(c, domainErrors.ErrPreconditionRequired)
+			return false
+		}
+		return true
+	}
+
+	version, ok := ParseETag(header)
+	if !ok {
+		response.PreconditionFailed(c, domainErrors.ErrVersionConflict)
+		return false
+	}
+	conditional.SetIfMatch(version)
+	return true
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+)
+
+const (
+	principalKey = "auth.principal"
+	apiKeyHeader = "X-API-Key"
+	bearerPrefix = "Bearer "
+)
+
+// Authenticator resolves request credentials to a principal.
+type Authenticator interface {
+	AuthenticateToken(ctx context.Context, token string) (*auth.Principal, error)
+	AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error)
+}
+
+// RequireAuth rejects requests without valid credentials.
+func RequireAuth(a Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !authenticate(c, a) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireAuthForWrites leaves safe methods public and requires credentials
+// for everything else. Credentials sent with a safe method are still
+// checked, so handlers can tell who is reading.
+func RequireAuthForWrites(a Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			if !hasCredentials(c) {
+				c.Next()
+				return
+			}
+		}
+		if !authenticate(c, a) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// PrincipalFrom returns the principal stored by the auth middleware.
+func PrincipalFrom(c *gin.Context) (*auth.Principal, bool) {
+	value, ok := c.Get(principalKey)
+	if !ok {
+		return nil, false
+	}
+	principal, ok := value.(*auth.Principal)
+	return principal, ok
+}
+
+func authenticate(c *gin.Context, a Authenticator) bool {
+	principal, err := resolvePrincipal(c, a)
+	if err != nil {
+		response.Unauthorized(c, err)
+		c.Abort()
+		return false
+	}
+	c.Set(principalKey, principal)
+	return true
+}
+
+func hasCredentials(c *gin.Context) bool {
+	return c.GetHeader(apiKeyHeader) != "" || strings.HasPrefix(c.GetHeader("Authorization"), bearerPrefix)
+}
+
+func resolvePrincipal(c *gin.Context, a Authenticator) (*auth.Principal, error) {
+	if key := c.GetHeader(apiKeyHeader); key != "" {
+		return a.AuthenticateAPIKey(c.Request.Context(), key)
+	}
+
+	header := c.GetHeader("Authorization")
+	if token, ok := strings.CutPrefix(header, bearerPrefix); ok && token != "" {
+		return a.AuthenticateToken(c.Request.Context(), token)
+	}
+
+	return nil, errors.ErrUnauthenticated
+}
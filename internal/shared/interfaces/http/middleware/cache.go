@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// privateCacheControl keeps responses that may depend on the caller, such as
+// drafts or the trash, out of shared caches.
+const privateCacheControl = "private, no-cache"
+
+// CacheControl sets Cache-Control on reads from the policy of the resource
+// they address, the first path segment after the group prefix, falling back to
+// defaultPolicy. Requests carrying credentials are always private.
+func CacheControl(defaultPolicy string, policies map[string]string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		if hasCredentials(c) {
+			c.Header("Cache-Control", privateCacheControl)
+		} else if policy := cachePolicy(c, defaultPolicy, policies); policy != "" {
+			c.Header("Cache-Control", policy)
+		}
+		c.Next()
+	}
+}
+
+func cachePolicy(c *gin.Context, defaultPolicy string, policies map[string]string) string {
+	route := c.FullPath()
+	if route == "" {
+		return ""
+	}
+
+	segments := strings.Split(strings.TrimPrefix(route, "/"), "/")
+	if len(segments) > 1 {
+		if policy, ok := policies[segments[1]]; ok {
+			return policy
+		}
+	}
+	return defaultPolicy
+}
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+)
+
+// ErrorFormat makes the error responses of every request follow format,
+// which still yields to the request's Accept header.
+func ErrorFormat(format response.ErrorFormat) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		response.SetErrorFormat(c, format)
+		c.Next()
+	}
+}
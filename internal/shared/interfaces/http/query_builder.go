@@ -8,6 +8,7 @@ import (
 
 	"github.com/jambo0624/blog/internal/shared/domain/constants"
 	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/domain/query"
 )
 
 // BaseQueryBuilder handles common query parameters.
@@ -90,3 +91,37 @@ func (b *BaseQueryBuilder) BuildOrderBy(c *gin.Context, additionalFields map[str
 	}
 	return "", nil
 }
+
+// BuildKeyset applies the cursor and count parameters. It must run after the
+// order by has been set, since a cursor is only valid for the sort it was
+// issued under.
+func (b *BaseQueryBuilder) BuildKeyset(c *gin.Context, q *query.BaseQuery) error {
+	if token := c.Query("cursor"); token != "" {
+		cursor, err := query.DecodeCursor(token)
+		if err != nil {
+			return err
+		}
+		field, desc := query.ParseOrderBy(q.OrderBy)
+		if cursor.Field != field || cursor.Desc != desc {
+			return errors.ErrCursorSortMismatch
+		}
+		q.WithCursor(cursor)
+	}
+
+	if c.Query("count") == "false" {
+		q.WithoutCount()
+	}
+
+	return nil
+}
+
+// BuildDeleted applies the with_deleted and only_deleted parameters.
+// only_deleted wins when both are set.
+func (b *BaseQueryBuilder) BuildDeleted(c *gin.Context, q *query.BaseQuery) {
+	switch {
+	case c.Query("only_deleted") == "true":
+		q.OnlyDeleted()
+	case c.Query("with_deleted") == "true":
+		q.WithDeleted()
+	}
+}
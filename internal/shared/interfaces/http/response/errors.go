@@ -0,0 +1,60 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+)
+
+// errorStatus is the HTTP status and business code a kind of domain error
+// is answered with.
+type errorStatus struct {
+	httpCode int
+	code     int
+}
+
+var kindStatuses = map[domainErrors.Kind]errorStatus{
+	domainErrors.KindNotFound:             {http.StatusNotFound, CodeNotFound},
+	domainErrors.KindConflict:             {http.StatusConflict, CodeConflict},
+	domainErrors.KindValidation:           {http.StatusBadRequest, CodeInvalidParams},
+	domainErrors.KindPrecondition:         {http.StatusPreconditionFailed, CodePreconditionFailed},
+	domainErrors.KindPreconditionRequired: {http.StatusPreconditionRequired, CodePreconditionRequired},
+	domainErrors.KindUnauthenticated:      {http.StatusUnauthorized, CodeUnauthorized},
+	domainErrors.KindForbidden:            {http.StatusForbidden, CodeForbidden},
+}
+
+// HandleError writes the error response for err, taking status and business
+// code from the kind of domain error it wraps. Field errors answer 422 and
+// errors of no known kind are internal errors. The message is the domain
+// error's own, the causes it wraps are only recorded on the context for the
+// request log. Not found responses keep the generic message so lookups do not
+// leak how they failed.
+func HandleError(c *gin.Context, err error) {
+	var fieldErrs dto.ValidationErrors
+	if errors.As(err, &fieldErrs) {
+		ValidationError(c, err)
+		return
+	}
+
+	var domainErr *domainErrors.Error
+	if !errors.As(err, &domainErr) {
+		InternalError(c, err)
+		return
+	}
+	status, ok := kindStatuses[domainErr.Kind()]
+	if !ok {
+		InternalError(c, err)
+		return
+	}
+	_ = c.Error(err)
+
+	message := domainErr.Error()
+	if domainErr.Kind() == domainErrors.KindNotFound {
+		message = ""
+	}
+	Error(c, status.httpCode, status.code, message)
+}
@@ -1,20 +1,19 @@
 package response
 
 import (
-	"strings"
-
 	"github.com/jambo0624/blog/internal/shared/domain/query"
 )
 
 // Meta standard metadata structure.
 type Meta struct {
-	Total       int         `json:"total"`                 // Total number of records
+	Total       *int        `json:"total,omitempty"`       // Total number of records, omitted when not counted
 	Limit       int         `json:"limit,omitempty"`       // Page size
 	Offset      int         `json:"offset,omitempty"`      // Page offset
 	Page        int         `json:"page,omitempty"`        // Current page number
 	TotalPages  int         `json:"totalPages,omitempty"`  // Total number of pages
 	Sort        string      `json:"sort,omitempty"`        // Sort field
 	Order       string      `json:"order,omitempty"`       // Sort order (asc/desc)
+	NextCursor  string      `json:"nextCursor,omitempty"`  // Token for the next keyset page
 	Filter      interface{} `json:"filter,omitempty"`      // Applied filters
 	Aggregation interface{} `json:"aggregation,omitempty"` // Aggregation results
 }
@@ -22,7 +21,7 @@ type Meta struct {
 // NewMeta creates a new Meta instance with pagination info.
 func NewMeta(total, limit, offset int) *Meta {
 	meta := &Meta{
-		Total:  total,
+		Total:  &total,
 		Limit:  limit,
 		Offset: offset,
 	}
@@ -42,6 +41,19 @@ func (m *Meta) WithSort(field, order string) *Meta {
 	return m
 }
 
+// WithoutTotal drops the total and page count when the count was skipped.
+func (m *Meta) WithoutTotal() *Meta {
+	m.Total = nil
+	m.TotalPages = 0
+	return m
+}
+
+// WithNextCursor adds the token for the next keyset page.
+func (m *Meta) WithNextCursor(cursor string) *Meta {
+	m.NextCursor = cursor
+	return m
+}
+
 // WithFilter adds filter information.
 func (m *Meta) WithFilter(filter interface{}) *Meta {
 	m.Filter = filter
@@ -60,13 +72,22 @@ func NewMetaFromQuery(total int64, baseQuery query.BaseQuery) *Meta {
 
 	// Add sort info
 	if baseQuery.OrderBy != "" {
-		field := strings.TrimSuffix(strings.TrimPrefix(baseQuery.OrderBy, "-"), " DESC")
+		field, desc := query.ParseOrderBy(baseQuery.OrderBy)
 		order := "asc"
-		if strings.HasSuffix(baseQuery.OrderBy, " DESC") || strings.HasPrefix(baseQuery.OrderBy, "-") {
+		if desc {
 			order = "desc"
 		}
 		meta.WithSort(field, order)
 	}
 
+	// Keyset pages have no meaningful page number
+	if baseQuery.Cursor != nil {
+		meta.Page = 0
+	}
+
+	if baseQuery.SkipCount {
+		meta.WithoutTotal()
+	}
+
 	return meta
 }
@@ -0,0 +1,95 @@
+package response
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+)
+
+// Error formats.
+const (
+	FormatEnvelope = "envelope" // {code,message,data} documents
+	FormatProblem  = "problem"  // RFC 7807 problem details
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details.
+const ProblemContentType = "application/problem+json"
+
+const (
+	errorFormatKey         = "response.errorFormat"
+	defaultProblemTypeBase = "/problems"
+)
+
+// ErrorFormat decides how the error responses of a request are rendered.
+type ErrorFormat struct {
+	Default     string // FormatEnvelope or FormatProblem, unless Accept prefers the other
+	TypeBaseURL string // Problem types are <TypeBaseURL>/<business code>, "/problems" when empty
+}
+
+// SetErrorFormat stores the error format for the rest of the request.
+// Requests without one answer with the envelope unless they accept
+// problem details explicitly.
+func SetErrorFormat(c *gin.Context, format ErrorFormat) {
+	c.Set(errorFormatKey, format)
+}
+
+// Problem is an RFC 7807 problem details document. Code and Errors are
+// extension members carrying the business code and the rejected fields.
+type Problem struct {
+	Type     string               `json:"type"`
+	Title    string               `json:"title"`
+	Status   int                  `json:"status"`
+	Detail   string               `json:"detail,omitempty"`
+	Instance string               `json:"instance,omitempty"`
+	Code     int                  `json:"code"`
+	Errors   dto.ValidationErrors `json:"errors,omitempty"`
+}
+
+// writeError renders an error response in the format the request negotiated.
+func writeError(c *gin.Context, httpCode, code int, msg string, fieldErrs dto.ValidationErrors) {
+	var format ErrorFormat
+	if value, ok := c.Get(errorFormatKey); ok {
+		format, _ = value.(ErrorFormat)
+	}
+
+	if acceptsProblem(c, format) {
+		base := format.TypeBaseURL
+		if base == "" {
+			base = defaultProblemTypeBase
+		}
+		c.Header("Content-Type", ProblemContentType)
+		c.JSON(httpCode, Problem{
+			Type:     base + "/" + strconv.Itoa(code),
+			Title:    messages[code],
+			Status:   httpCode,
+			Detail:   msg,
+			Instance: c.Request.URL.Path,
+			Code:     code,
+			Errors:   fieldErrs,
+		})
+		return
+	}
+
+	message := msg
+	if message == "" {
+		message = messages[code]
+	}
+	c.JSON(httpCode, Response{
+		Code:    code,
+		Message: message,
+		Errors:  fieldErrs,
+	})
+}
+
+// acceptsProblem negotiates between the envelope and problem details,
+// offering the configured default first so it wins for */* and no Accept.
+func acceptsProblem(c *gin.Context, format ErrorFormat) bool {
+	offered := []string{binding.MIMEJSON, ProblemContentType}
+	if format.Default == FormatProblem {
+		offered = []string{ProblemContentType, binding.MIMEJSON}
+	}
+	return c.NegotiateFormat(offered...) == ProblemContentType
+}
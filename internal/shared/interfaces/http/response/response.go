@@ -1,39 +1,49 @@
 package response
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
 )
 
 // Response standard response structure.
 type Response struct {
-	Code    int         `json:"code"`           // Business status code
-	Message string      `json:"message"`        // Response message
-	Data    interface{} `json:"data"`           // Response data
-	Meta    Meta        `json:"meta,omitempty"` // Metadata (pagination, etc.)
+	Code    int                  `json:"code"`             // Business status code
+	Message string               `json:"message"`          // Response message
+	Data    interface{}          `json:"data"`             // Response data
+	Meta    Meta                 `json:"meta,omitempty"`   // Metadata (pagination, etc.)
+	Errors  dto.ValidationErrors `json:"errors,omitempty"` // Rejected fields of a validation error
 }
 
 // Predefined status codes.
 const (
-	CodeSuccess          = 0
-	CodeInvalidParams    = 400001
-	CodeUnauthorized     = 401001
-	CodeForbidden        = 403001
-	CodeNotFound         = 404001
-	CodeInternalError    = 500001
-	CodeValidationFailed = 422001
+	CodeSuccess              = 0
+	CodeInvalidParams        = 400001
+	CodeUnauthorized         = 401001
+	CodeForbidden            = 403001
+	CodeNotFound             = 404001
+	CodeConflict             = 409001
+	CodePreconditionFailed   = 412001
+	CodePreconditionRequired = 428001
+	CodeInternalError        = 500001
+	CodeValidationFailed     = 422001
 )
 
 // Predefined messages.
 var messages = map[int]string{
-	CodeSuccess:          "success",
-	CodeInvalidParams:    "invalid parameters",
-	CodeUnauthorized:     "unauthorized",
-	CodeForbidden:        "forbidden",
-	CodeNotFound:         "resource not found",
-	CodeInternalError:    "internal server error",
-	CodeValidationFailed: "validation failed",
+	CodeSuccess:              "success",
+	CodeInvalidParams:        "invalid parameters",
+	CodeUnauthorized:         "unauthorized",
+	CodeForbidden:            "forbidden",
+	CodeNotFound:             "resource not found",
+	CodeConflict:             "conflict",
+	CodePreconditionFailed:   "precondition failed",
+	CodePreconditionRequired: "precondition required",
+	CodeInternalError:        "internal server error",
+	CodeValidationFailed:     "validation failed",
 }
 
 // Success successful response.
@@ -69,21 +79,21 @@ func NoContent(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
-// Error error response.
+// Error error response, rendered as problem details when the request
+// negotiated them.
 func Error(c *gin.Context, httpCode, code int, msg string) {
-	message := msg
-	if message == "" {
-		message = messages[code]
-	}
-	c.JSON(httpCode, Response{
-		Code:    code,
-		Message: message,
-	})
+	writeError(c, httpCode, code, msg, nil)
 }
 
-// ValidationError validation error response.
+// ValidationError validation error response, listing the rejected fields
+// when err carries dto.ValidationErrors.
 func ValidationError(c *gin.Context, err error) {
-	Error(c, http.StatusUnprocessableEntity, CodeValidationFailed, err.Error())
+	var fieldErrs dto.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		Error(c, http.StatusUnprocessableEntity, CodeValidationFailed, err.Error())
+		return
+	}
+	writeError(c, http.StatusUnprocessableEntity, CodeValidationFailed, "", fieldErrs)
 }
 
 // BadRequest bad request response.
@@ -91,11 +101,31 @@ func BadRequest(c *gin.Context, err error) {
 	Error(c, http.StatusBadRequest, CodeInvalidParams, err.Error())
 }
 
+// Unauthorized missing or invalid credentials response.
+func Unauthorized(c *gin.Context, err error) {
+	Error(c, http.StatusUnauthorized, CodeUnauthorized, err.Error())
+}
+
+// Forbidden insufficient permissions response.
+func Forbidden(c *gin.Context, err error) {
+	Error(c, http.StatusForbidden, CodeForbidden, err.Error())
+}
+
 // NotFound resource not found response.
 func NotFound(c *gin.Context) {
 	Error(c, http.StatusNotFound, CodeNotFound, "")
 }
 
+// PreconditionFailed stale If-Match version response.
+func PreconditionFailed(c *gin.Context, err error) {
+	Error(c, http.StatusPreconditionFailed, CodePreconditionFailed, err.Error())
+}
+
+// PreconditionRequired missing If-Match response.
+func PreconditionRequired(c *gin.Context, err error) {
+	Error(c, http.StatusPreconditionRequired, CodePreconditionRequired, err.Error())
+}
+
 // InternalError internal server error response.
 func InternalError(c *gin.Context, err error) {
 	Error(c, http.StatusInternalServerError, CodeInternalError, err.Error())
@@ -1,11 +1,13 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/getsentry/sentry-go"
 
 	"github.com/jambo0624/blog/internal/shared/application/service"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
 	"github.com/jambo0624/blog/internal/shared/domain/repository"
 	"github.com/jambo0624/blog/internal/tag/domain/entity"
 	"github.com/jambo0624/blog/internal/tag/domain/query"
@@ -14,23 +16,25 @@ import (
 
 type TagService struct {
 	*service.BaseService[entity.Tag, *query.TagQuery]
+	*service.ResourceGuard
 }
 
 func NewTagService(repo repository.BaseRepository[entity.Tag, *query.TagQuery]) *TagService {
 	baseService := service.NewBaseService(repo)
 	return &TagService{
-		BaseService: baseService,
+		BaseService:   baseService,
+		ResourceGuard: service.NewResourceGuard(auth.ResourceTag),
 	}
 }
 
-func (s *TagService) Create(req *dto.CreateTagRequest) (*entity.Tag, error) {
+func (s *TagService) Create(ctx context.Context, req *dto.CreateTagRequest) (*entity.Tag, error) {
 	tag, err := entity.NewTag(req.Name, req.Color)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil, fmt.Errorf("failed to create tag: %w", err)
 	}
 
-	if err := s.Repo.Save(tag); err != nil {
+	if err := s.Repo.Save(ctx, tag); err != nil {
 		sentry.CaptureException(err)
 		return nil, fmt.Errorf("failed to save tag: %w", err)
 	}
@@ -38,16 +42,20 @@ func (s *TagService) Create(req *dto.CreateTagRequest) (*entity.Tag, error) {
 	return tag, nil
 }
 
-func (s *TagService) Update(id uint, req *dto.UpdateTagRequest) (*entity.Tag, error) {
-	tag, err := s.FindByID(id)
+func (s *TagService) Update(ctx context.Context, id uint, req *dto.UpdateTagRequest) (*entity.Tag, error) {
+	tag, err := s.FindByID(ctx, id)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil, fmt.Errorf("failed to find tag by id: %w", err)
 	}
 
+	if err := req.CheckVersion(tag.Version); err != nil {
+		return nil, err
+	}
+
 	tag.Update(req)
 
-	if err := s.Repo.Update(tag); err != nil {
+	if err := s.Repo.Update(ctx, tag); err != nil {
 		sentry.CaptureException(err)
 		return nil, fmt.Errorf("failed to update tag: %w", err)
 	}
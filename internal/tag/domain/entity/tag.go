@@ -3,17 +3,20 @@ package entity
 import (
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/jambo0624/blog/internal/shared/domain/errors"
 	"github.com/jambo0624/blog/internal/tag/interfaces/http/dto"
 )
 
 type Tag struct {
-	ID        uint       `binding:"required"               gorm:"primary_key"              json:"id"`
-	Name      string     `binding:"required, max=100"      gorm:"size:100;not null;unique" json:"name"`
-	Color     string     `binding:"required, hexcolor"     gorm:"size:50"                  json:"color"`
-	CreatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
-	UpdatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
-	DeletedAt *time.Time `gorm:"index"                     json:"deletedAt"`
+	ID        uint           `binding:"required"               gorm:"primary_key"              json:"id"`
+	Name      string         `binding:"required, max=100"      gorm:"size:100;not null;unique" json:"name"`
+	Color     string         `binding:"required, hexcolor"     gorm:"size:50"                  json:"color"`
+	Version   uint           `gorm:"not null;default:1"        json:"version"`
+	CreatedAt time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
+	DeletedAt gorm.DeletedAt `gorm:"index"                     json:"deletedAt"`
 }
 
 // NewTag create new tag, all fields are required.
@@ -38,6 +41,21 @@ func (t Tag) GetID() uint {
 	return t.ID
 }
 
+// GetUpdatedAt get tag last modification time, implement Timestamped interface.
+func (t Tag) GetUpdatedAt() time.Time {
+	return t.UpdatedAt
+}
+
+// GetVersion get tag version, implement Versioned interface.
+func (t Tag) GetVersion() uint {
+	return t.Version
+}
+
+// SetVersion set tag version, implement Versioned interface.
+func (t *Tag) SetVersion(version uint) {
+	t.Version = version
+}
+
 // Update update tag, only update provided fields.
 func (t *Tag) Update(req *dto.UpdateTagRequest) {
 	if req.Name != "" {
@@ -1,6 +1,8 @@
 package persistence
 
 import (
+	"context"
+
 	"gorm.io/gorm"
 
 	persistence "github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
@@ -18,3 +20,10 @@ func NewGormTagRepository(db *gorm.DB) tagRepository.TagRepository {
 		BaseGormRepository: persistence.NewBaseGormRepository[tagEntity.Tag, *tagQuery.TagQuery](db),
 	}
 }
+
+// Purge permanently removes a trashed tag and detaches it from articles.
+func (r *GormTagRepository) Purge(ctx context.Context, id uint) error {
+	return r.PurgeWith(ctx, id, func(tx *gorm.DB) error {
+		return tx.Exec("DELETE FROM article_tags WHERE tag_id = ?", id).Error
+	})
+}
@@ -1,21 +1,33 @@
 package dto
 
+import (
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	sharedDto "github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+)
+
 type CreateTagRequest struct {
-	Name  string `binding:"required,max=50"   json:"name"`
-	Color string `binding:"required,hexcolor" json:"color"`
+	Name  string `binding:"required" json:"name"`
+	Color string `binding:"required" json:"color"`
 }
 
 type UpdateTagRequest struct {
-	Name  string `binding:"omitempty,max=100"  json:"name"`
-	Color string `binding:"omitempty,hexcolor" json:"color"`
+	sharedDto.Precondition
+
+	Name  string `binding:"omitempty" json:"name"`
+	Color string `binding:"omitempty" json:"color"`
 }
 
 func (r CreateTagRequest) Validate() error {
-	// Business rules validation
-	return nil
+	return validateTag(r.Name, r.Color)
 }
 
 func (r UpdateTagRequest) Validate() error {
-	// Business rules validation
-	return nil
+	return validateTag(r.Name, r.Color)
+}
+
+func validateTag(name, color string) error {
+	var errs sharedDto.ValidationErrors
+	errs.CheckLength("name", name, constants.MinNameLength, constants.MaxNameLength)
+	errs.CheckHexColor("color", color)
+	return errs.Err()
 }
@@ -60,6 +60,14 @@ func (h *TagHandler) buildQuery(c *gin.Context) (*tagQuery.TagQuery, error) {
 		q.WithOrderBy(orderBy)
 	}
 
+	// Build cursor and count options
+	if err := builder.BuildKeyset(c, &q.BaseQuery); err != nil {
+		return nil, err
+	}
+
+	// Build trash scope
+	builder.BuildDeleted(c, &q.BaseQuery)
+
 	return q, nil
 }
 
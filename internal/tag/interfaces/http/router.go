@@ -22,5 +22,7 @@ func (r *TagRouter) Register(api *gin.RouterGroup) {
 		tags.GET("/:id", r.handler.FindByID)
 		tags.PUT("/:id", r.handler.Update)
 		tags.DELETE("/:id", r.handler.Delete)
+		tags.POST("/:id/restore", r.handler.Restore)
+		tags.DELETE("/:id/purge", r.handler.Purge)
 	}
 }
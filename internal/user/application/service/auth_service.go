@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/clock"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/user/domain/entity"
+	userRepository "github.com/jambo0624/blog/internal/user/domain/repository"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+)
+
+// TokenIssuer signs and verifies access tokens.
+type TokenIssuer interface {
+	Issue(userID uint, now time.Time) (string, time.Time, error)
+	Parse(token string, now time.Time) (uint, error)
+}
+
+// LoginResult is returned on a successful login.
+type LoginResult struct {
+	Token     string       `json:"token"`
+	ExpiresAt time.Time    `json:"expiresAt"`
+	User      *entity.User `json:"user"`
+}
+
+// CreatedAPIKey carries the plaintext key, which is only available once.
+type CreatedAPIKey struct {
+	*entity.APIKey
+	Key string `json:"key"`
+}
+
+// AuthService handles logins, token and API key verification.
+type AuthService struct {
+	userRepo   userRepository.UserRepository
+	apiKeyRepo userRepository.APIKeyRepository
+	tokens     TokenIssuer
+	clock      clock.Clock
+}
+
+func NewAuthService(
+	ur userRepository.UserRepository,
+	kr userRepository.APIKeyRepository,
+	tokens TokenIssuer,
+) *AuthService {
+	return &AuthService{
+		userRepo:   ur,
+		apiKeyRepo: kr,
+		tokens:     tokens,
+		clock:      clock.New(),
+	}
+}
+
+// WithClock replaces the clock used for token and key expiry.
+func (s *AuthService) WithClock(c clock.Clock) *AuthService {
+	s.clock = c
+	return s
+}
+
+// Login checks the credentials and issues an access token.
+func (s *AuthService) Login(ctx context.Context, req *dto.LoginRequest) (*LoginResult, error) {
+	user, err := s.userRepo.FindByEmail(ctx, entity.NormalizeEmail(req.Email))
+	if err != nil {
+		entity.CheckNoPassword(req.Password)
+		return nil, errors.ErrInvalidCredentials
+	}
+	if !user.CheckPassword(req.Password) {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	token, expiresAt, err := s.tokens.Issue(user.ID, s.clock.Now())
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to issue token: %w", err)
+	}
+
+	return &LoginResult{Token: token, ExpiresAt: expiresAt, User: user}, nil
+}
+
+// AuthenticateToken resolves a bearer token to its user.
+func (s *AuthService) AuthenticateToken(ctx context.Context, token string) (*auth.Principal, error) {
+	userID, err := s.tokens.Parse(token, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.activeUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.Principal{UserID: userID, Role: user.Role, Method: auth.MethodJWT}, nil
+}
+
+// AuthenticateAPIKey resolves a plaintext API key to its owner.
+func (s *AuthService) AuthenticateAPIKey(ctx context.Context, plaintext string) (*auth.Principal, error) {
+	key, err := s.apiKeyRepo.FindByHash(ctx, entity.HashAPIKey(plaintext))
+	if err != nil {
+		return nil, errors.ErrInvalidToken
+	}
+
+	now := s.clock.Now()
+	if err := key.Verify(now); err != nil {
+		return nil, err
+	}
+	user, err := s.activeUser(ctx, key.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Touch(now)
+	if err := s.apiKeyRepo.Update(ctx, key); err != nil {
+		// Recording last use is best effort and must not block the request.
+		sentry.CaptureException(err)
+	}
+
+	return &auth.Principal{UserID: key.UserID, Role: user.Role, Method: auth.MethodAPIKey}, nil
+}
+
+// CurrentUser returns the user behind a principal.
+func (s *AuthService) CurrentUser(ctx context.Context, principal *auth.Principal) (*entity.User, error) {
+	return s.activeUser(ctx, principal.UserID)
+}
+
+// CreateAPIKey issues a new API key for the user.
+func (s *AuthService) CreateAPIKey(ctx context.Context, userID uint, req *dto.CreateAPIKeyRequest) (*CreatedAPIKey, error) {
+	key, plaintext, err := entity.NewAPIKey(userID, req.Name, req.ExpiresAt, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	if err := s.apiKeyRepo.Save(ctx, key); err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to save api key: %w", err)
+	}
+
+	return &CreatedAPIKey{APIKey: key, Key: plaintext}, nil
+}
+
+// FindAPIKeys lists the user's API keys.
+func (s *AuthService) FindAPIKeys(ctx context.Context, userID uint) ([]*entity.APIKey, error) {
+	keys, err := s.apiKeyRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to find api keys: %w", err)
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revokes one of the user's API keys.
+func (s *AuthService) RevokeAPIKey(ctx context.Context, userID, keyID uint) (*entity.APIKey, error) {
+	keys, err := s.FindAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if key.ID != keyID {
+			continue
+		}
+		key.Revoke(s.clock.Now())
+		if err := s.apiKeyRepo.Update(ctx, key); err != nil {
+			sentry.CaptureException(err)
+			return nil, fmt.Errorf("failed to revoke api key: %w", err)
+		}
+		return key, nil
+	}
+
+	return nil, errors.ErrAPIKeyNotFound
+}
+
+func (s *AuthService) activeUser(ctx context.Context, userID uint) (*entity.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrInvalidToken
+	}
+	return user, nil
+}
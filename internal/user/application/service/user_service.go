@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/jambo0624/blog/internal/shared/application/service"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/repository"
+	"github.com/jambo0624/blog/internal/user/domain/entity"
+	"github.com/jambo0624/blog/internal/user/domain/query"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+)
+
+type UserService struct {
+	*service.BaseService[entity.User, *query.UserQuery]
+	*service.ResourceGuard
+}
+
+func NewUserService(repo repository.BaseRepository[entity.User, *query.UserQuery]) *UserService {
+	baseService := service.NewBaseService(repo)
+	return &UserService{
+		BaseService:   baseService,
+		ResourceGuard: service.NewResourceGuard(auth.ResourceUser),
+	}
+}
+
+func (s *UserService) Create(ctx context.Context, req *dto.CreateUserRequest) (*entity.User, error) {
+	user, err := entity.NewUser(req.Email, req.Name, req.Password)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	if req.Role != "" {
+		if err := user.SetRole(auth.Role(req.Role)); err != nil {
+			return nil, fmt.Errorf("failed to create user: %w", err)
+		}
+	}
+	user.Bio = req.Bio
+	user.AvatarURL = req.AvatarURL
+
+	if err := s.Repo.Save(ctx, user); err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *UserService) Update(ctx context.Context, id uint, req *dto.UpdateUserRequest) (*entity.User, error) {
+	user, err := s.FindByID(ctx, id)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to find user by id: %w", err)
+	}
+
+	if err := user.Update(req); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	if err := s.Repo.Update(ctx, user); err != nil {
+		sentry.CaptureException(err)
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return user, nil
+}
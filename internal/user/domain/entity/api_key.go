@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+const (
+	apiKeyPrefix      = "blog_"
+	apiKeyBytes       = 32
+	apiKeyDisplaySize = 12
+)
+
+// APIKey is a long-lived credential for non-interactive clients such as CI.
+// Only a SHA-256 hash of the key is stored; the plaintext is shown once.
+type APIKey struct {
+	ID         uint       `gorm:"primary_key"                  json:"id"`
+	UserID     uint       `gorm:"not null;index"               json:"userId"`
+	Name       string     `gorm:"size:100;not null"            json:"name"`
+	Prefix     string     `gorm:"size:20;not null"             json:"prefix"`
+	KeyHash    string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `gorm:"default:CURRENT_TIMESTAMP"    json:"createdAt"`
+}
+
+// NewAPIKey generates a key for the user and returns it with its plaintext.
+func NewAPIKey(userID uint, name string, expiresAt *time.Time, now time.Time) (*APIKey, string, error) {
+	if name == "" {
+		return nil, "", errors.ErrNameRequired
+	}
+
+	secret := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	plaintext := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	return &APIKey{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    plaintext[:apiKeyDisplaySize],
+		KeyHash:   HashAPIKey(plaintext),
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}, plaintext, nil
+}
+
+// HashAPIKey returns the stored form of a plaintext key.
+func HashAPIKey(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify reports whether the key may still be used at now.
+func (k *APIKey) Verify(now time.Time) error {
+	if k.RevokedAt != nil {
+		return errors.ErrAPIKeyRevoked
+	}
+	if k.ExpiresAt != nil && !now.Before(*k.ExpiresAt) {
+		return errors.ErrAPIKeyExpired
+	}
+	return nil
+}
+
+// Revoke disables the key.
+func (k *APIKey) Revoke(now time.Time) {
+	if k.RevokedAt == nil {
+		k.RevokedAt = &now
+	}
+}
+
+// Touch records a successful use of the key.
+func (k *APIKey) Touch(now time.Time) {
+	k.LastUsedAt = &now
+}
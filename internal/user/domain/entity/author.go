@@ -0,0 +1,21 @@
+package entity
+
+// Author is the public profile of a user who writes articles. It reads the
+// users table but leaves out credentials, email and role, so it is safe to
+// embed in public article responses.
+type Author struct {
+	ID          uint   `gorm:"primary_key"       json:"id"`
+	DisplayName string `gorm:"column:name"       json:"displayName"`
+	Bio         string `gorm:"column:bio"        json:"bio"`
+	AvatarURL   string `gorm:"column:avatar_url" json:"avatarUrl"`
+}
+
+// TableName maps Author onto the users table.
+func (Author) TableName() string {
+	return "users"
+}
+
+// GetID get author id, implement Entity interface.
+func (a Author) GetID() uint {
+	return a.ID
+}
@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+)
+
+type User struct {
+	ID           uint           `binding:"required"                     gorm:"primary_key" json:"id"`
+	Email        string         `gorm:"size:255;not null;unique"        json:"email"`
+	Name         string         `gorm:"size:100;not null"               json:"name"`
+	PasswordHash string         `gorm:"size:255;not null"               json:"-"`
+	Role         auth.Role      `gorm:"size:20;not null;default:reader" json:"role"`
+	Bio          string         `gorm:"type:text;not null;default:''"   json:"bio"`
+	AvatarURL    string         `gorm:"size:255;not null;default:''"    json:"avatarUrl"`
+	CreatedAt    time.Time      `gorm:"default:CURRENT_TIMESTAMP"       json:"createdAt"`
+	UpdatedAt    time.Time      `gorm:"default:CURRENT_TIMESTAMP"       json:"updatedAt"`
+	DeletedAt    gorm.DeletedAt `gorm:"index"                           json:"deletedAt"`
+}
+
+// NewUser create new user with a hashed password, all fields are required.
+// New users are readers until given another role.
+func NewUser(email, name, password string) (*User, error) {
+	email = NormalizeEmail(email)
+	if email == "" {
+		return nil, errors.ErrEmailRequired
+	}
+	if name == "" {
+		return nil, errors.ErrNameRequired
+	}
+
+	user := &User{
+		Email:     email,
+		Name:      name,
+		Role:      auth.RoleReader,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := user.SetPassword(password); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// NormalizeEmail lowercases and trims an email so lookups are case-insensitive.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// GetID get user id, implement Entity interface.
+func (u User) GetID() uint {
+	return u.ID
+}
+
+// SetPassword replaces the stored hash with a bcrypt hash of password.
+func (u *User) SetPassword(password string) error {
+	switch {
+	case password == "":
+		return errors.ErrPasswordRequired
+	case len(password) < constants.MinPasswordLength:
+		return errors.ErrPasswordTooShort
+	case len(password) > constants.MaxPasswordLength:
+		return errors.ErrPasswordTooLong
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	u.PasswordHash = string(hash)
+	return nil
+}
+
+// SetRole changes the user's role.
+func (u *User) SetRole(role auth.Role) error {
+	if !role.IsValid() {
+		return errors.ErrInvalidRole
+	}
+	u.Role = role
+	return nil
+}
+
+// CheckPassword reports whether password matches the stored hash.
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
+
+// dummyPasswordHash is a hash no password is checked against for real, at
+// the cost SetPassword uses.
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// CheckNoPassword spends as long as CheckPassword does without a user to
+// check against, so a login with an unknown email cannot be told apart from
+// one with a wrong password by its response time.
+func CheckNoPassword(password string) {
+	_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+}
+
+// Update update user, only update provided fields.
+func (u *User) Update(req *dto.UpdateUserRequest) error {
+	if req.Name != "" {
+		u.Name = req.Name
+	}
+	if req.Password != "" {
+		if err := u.SetPassword(req.Password); err != nil {
+			return err
+		}
+	}
+	if req.Bio != nil {
+		u.Bio = *req.Bio
+	}
+	if req.AvatarURL != nil {
+		u.AvatarURL = *req.AvatarURL
+	}
+	if req.Role != "" {
+		if err := u.SetRole(auth.Role(req.Role)); err != nil {
+			return err
+		}
+	}
+	u.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"gorm.io/gorm"
+
+	baseQuery "github.com/jambo0624/blog/internal/shared/domain/query"
+)
+
+type UserQuery struct {
+	baseQuery.BaseQuery
+	EmailLike string `binding:"omitempty,max=255" validate:"omitempty,max=255"`
+	NameLike  string `binding:"omitempty,max=100" validate:"omitempty,max=100"`
+}
+
+func NewUserQuery() *UserQuery {
+	return &UserQuery{
+		BaseQuery: baseQuery.NewBaseQuery(),
+	}
+}
+
+func (q *UserQuery) WithEmailLike(email string) *UserQuery {
+	q.EmailLike = email
+	return q
+}
+
+func (q *UserQuery) WithNameLike(name string) *UserQuery {
+	q.NameLike = name
+	return q
+}
+
+func (q *UserQuery) Validate() error {
+	return q.BaseQuery.ValidateQuery(q)
+}
+
+func (q *UserQuery) GetBaseQuery() baseQuery.BaseQuery {
+	return q.BaseQuery
+}
+
+func (q *UserQuery) ApplyFilters(db *gorm.DB) *gorm.DB {
+	if len(q.IDs) > 0 {
+		db = db.Where("id IN ?", q.IDs)
+	}
+	if q.EmailLike != "" {
+		db = db.Where("email LIKE ?", "%"+q.EmailLike+"%")
+	}
+	if q.NameLike != "" {
+		db = db.Where("name LIKE ?", "%"+q.NameLike+"%")
+	}
+
+	return db
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jambo0624/blog/internal/shared/domain/repository"
+	userEntity "github.com/jambo0624/blog/internal/user/domain/entity"
+	userQuery "github.com/jambo0624/blog/internal/user/domain/query"
+)
+
+type UserRepository interface {
+	repository.BaseRepository[userEntity.User, *userQuery.UserQuery]
+	FindByEmail(ctx context.Context, email string) (*userEntity.User, error)
+}
+
+type APIKeyRepository interface {
+	Save(ctx context.Context, key *userEntity.APIKey) error
+	Update(ctx context.Context, key *userEntity.APIKey) error
+	FindByHash(ctx context.Context, hash string) (*userEntity.APIKey, error)
+	FindByUserID(ctx context.Context, userID uint) ([]*userEntity.APIKey, error)
+}
@@ -0,0 +1,45 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	persistence "github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
+	userEntity "github.com/jambo0624/blog/internal/user/domain/entity"
+	userRepository "github.com/jambo0624/blog/internal/user/domain/repository"
+)
+
+type GormAPIKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewGormAPIKeyRepository(db *gorm.DB) userRepository.APIKeyRepository {
+	return &GormAPIKeyRepository{db: db}
+}
+
+func (r *GormAPIKeyRepository) Save(ctx context.Context, key *userEntity.APIKey) error {
+	return persistence.TranslateError(persistence.Conn(ctx, r.db).Create(key).Error)
+}
+
+func (r *GormAPIKeyRepository) Update(ctx context.Context, key *userEntity.APIKey) error {
+	return persistence.Conn(ctx, r.db).Save(key).Error
+}
+
+func (r *GormAPIKeyRepository) FindByHash(ctx context.Context, hash string) (*userEntity.APIKey, error) {
+	var key userEntity.APIKey
+	err := persistence.Conn(ctx, r.db).Where("key_hash = ?", hash).First(&key).Error
+	if err != nil {
+		return nil, persistence.TranslateError(err)
+	}
+	return &key, nil
+}
+
+func (r *GormAPIKeyRepository) FindByUserID(ctx context.Context, userID uint) ([]*userEntity.APIKey, error) {
+	var keys []*userEntity.APIKey
+	err := persistence.Conn(ctx, r.db).Where("user_id = ?", userID).Order("created_at DESC").Find(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
@@ -0,0 +1,33 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	persistence "github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
+	userEntity "github.com/jambo0624/blog/internal/user/domain/entity"
+	userQuery "github.com/jambo0624/blog/internal/user/domain/query"
+	userRepository "github.com/jambo0624/blog/internal/user/domain/repository"
+)
+
+type GormUserRepository struct {
+	*persistence.BaseGormRepository[userEntity.User, *userQuery.UserQuery]
+	db *gorm.DB
+}
+
+func NewGormUserRepository(db *gorm.DB) userRepository.UserRepository {
+	return &GormUserRepository{
+		BaseGormRepository: persistence.NewBaseGormRepository[userEntity.User, *userQuery.UserQuery](db),
+		db:                 db,
+	}
+}
+
+func (r *GormUserRepository) FindByEmail(ctx context.Context, email string) (*userEntity.User, error) {
+	var user userEntity.User
+	err := persistence.Conn(ctx, r.db).Where("email = ?", email).First(&user).Error
+	if err != nil {
+		return nil, persistence.TranslateError(err)
+	}
+	return &user, nil
+}
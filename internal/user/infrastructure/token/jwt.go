@@ -0,0 +1,66 @@
+package token
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+const issuer = "blog"
+
+// JWTIssuer signs and verifies HS256 access tokens whose subject is the
+// user ID.
+type JWTIssuer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewJWTIssuer(secret string, ttl time.Duration) *JWTIssuer {
+	return &JWTIssuer{
+		secret: []byte(secret),
+		ttl:    ttl,
+	}
+}
+
+// Issue returns a signed token for userID and its expiry.
+func (i *JWTIssuer) Issue(userID uint, now time.Time) (string, time.Time, error) {
+	expiresAt := now.Add(i.ttl)
+	claims := jwt.RegisteredClaims{
+		Issuer:    issuer,
+		Subject:   strconv.FormatUint(uint64(userID), 10),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+// Parse verifies the token at now and returns its user ID.
+func (i *JWTIssuer) Parse(tokenString string, now time.Time) (uint, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(*jwt.Token) (any, error) {
+		return i.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	if err != nil {
+		return 0, errors.ErrInvalidToken
+	}
+
+	userID, err := strconv.ParseUint(claims.Subject, 10, 32)
+	if err != nil || userID == 0 {
+		return 0, errors.ErrInvalidToken
+	}
+	return uint(userID), nil
+}
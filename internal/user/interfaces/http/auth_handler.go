@@ -0,0 +1,109 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+	"github.com/jambo0624/blog/internal/user/application/service"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+)
+
+type AuthHandler struct {
+	authService *service.AuthService
+}
+
+func NewAuthHandler(s *service.AuthService) *AuthHandler {
+	return &AuthHandler{authService: s}
+}
+
+// Login handles POST /login requests.
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req dto.LoginRequest
+	if !http.BindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.authService.Login(c.Request.Context(), &req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// Me handles GET /me requests.
+func (h *AuthHandler) Me(c *gin.Context) {
+	principal, ok := middleware.PrincipalFrom(c)
+	if !ok {
+		response.Unauthorized(c, errors.ErrUnauthenticated)
+		return
+	}
+
+	user, err := h.authService.CurrentUser(c.Request.Context(), principal)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	response.Success(c, user)
+}
+
+// CreateAPIKey handles POST /api-keys requests. The key is only returned here.
+func (h *AuthHandler) CreateAPIKey(c *gin.Context) {
+	principal, ok := middleware.PrincipalFrom(c)
+	if !ok {
+		response.Unauthorized(c, errors.ErrUnauthenticated)
+		return
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if !http.BindJSON(c, &req) {
+		return
+	}
+
+	key, err := h.authService.CreateAPIKey(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Created(c, key)
+}
+
+// FindAPIKeys handles GET /api-keys requests.
+func (h *AuthHandler) FindAPIKeys(c *gin.Context) {
+	principal, ok := middleware.PrincipalFrom(c)
+	if !ok {
+		response.Unauthorized(c, errors.ErrUnauthenticated)
+		return
+	}
+
+	keys, err := h.authService.FindAPIKeys(c.Request.Context(), principal.UserID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, keys)
+}
+
+// RevokeAPIKey handles DELETE /api-keys/:id requests.
+func (h *AuthHandler) RevokeAPIKey(c *gin.Context) {
+	principal, ok := middleware.PrincipalFrom(c)
+	if !ok {
+		response.Unauthorized(c, errors.ErrUnauthenticated)
+		return
+	}
+
+	id := http.ParseUintParam(c, "id")
+	if _, err := h.authService.RevokeAPIKey(c.Request.Context(), principal.UserID, id); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
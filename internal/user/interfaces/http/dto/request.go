@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+type CreateUserRequest struct {
+	Email     string `binding:"required,email,max=255"                     json:"email"`
+	Name      string `binding:"required,max=100"                           json:"name"`
+	Password  string `binding:"required,min=8,max=72"                      json:"password"`
+	Role      string `binding:"omitempty,oneof=admin editor author reader" json:"role"`
+	Bio       string `binding:"omitempty,max=2000"                         json:"bio"`
+	AvatarURL string `binding:"omitempty,url,max=255"                      json:"avatarUrl"`
+}
+
+// UpdateUserRequest takes the profile fields as pointers so they can be
+// cleared with an empty string.
+type UpdateUserRequest struct {
+	Name      string  `binding:"omitempty,max=100"                          json:"name"`
+	Password  string  `binding:"omitempty,min=8,max=72"                     json:"password"`
+	Role      string  `binding:"omitempty,oneof=admin editor author reader" json:"role"`
+	Bio       *string `binding:"omitempty,max=2000"                         json:"bio"`
+	AvatarURL *string `binding:"omitempty,max=255,eq=|url"                  json:"avatarUrl"`
+}
+
+type LoginRequest struct {
+	Email    string `binding:"required,email" json:"email"`
+	Password string `binding:"required"       json:"password"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string     `binding:"required,max=100" json:"name"`
+	ExpiresAt *time.Time `binding:"omitempty"        json:"expiresAt"`
+}
+
+func (r CreateUserRequest) Validate() error {
+	// Business rules validation
+	return nil
+}
+
+func (r UpdateUserRequest) Validate() error {
+	// Business rules validation
+	return nil
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http"
+	"github.com/jambo0624/blog/internal/user/application/service"
+	"github.com/jambo0624/blog/internal/user/domain/entity"
+	userQuery "github.com/jambo0624/blog/internal/user/domain/query"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+)
+
+type UserHandler struct {
+	*http.BaseHandler[entity.User, *userQuery.UserQuery, dto.CreateUserRequest, dto.UpdateUserRequest]
+}
+
+func NewUserHandler(s *service.UserService) *UserHandler {
+	baseHandler := http.NewBaseHandler(s.BaseService, s)
+	return &UserHandler{
+		BaseHandler: baseHandler,
+	}
+}
+
+func (h *UserHandler) buildQuery(c *gin.Context) (*userQuery.UserQuery, error) {
+	q := userQuery.NewUserQuery()
+	builder := http.NewBaseQueryBuilder()
+
+	// Build IDs
+	if ids, err := builder.BuildIDs(c); err != nil {
+		return nil, err
+	} else if ids != nil {
+		q.WithIDs(ids)
+	}
+
+	// Parse email
+	if email := c.Query("email"); email != "" {
+		if len(email) > constants.MaxEmailLength {
+			return nil, errors.ErrEmailTooLong
+		}
+		q.WithEmailLike(email)
+	}
+
+	// Parse name
+	if name := c.Query("name"); name != "" {
+		if len(name) > constants.MaxNameLength {
+			return nil, errors.ErrNameTooLong
+		}
+		q.WithNameLike(name)
+	}
+
+	// Build pagination
+	if limit, offset, err := builder.BuildPagination(c, q.Limit, q.Offset); err != nil {
+		return nil, err
+	} else {
+		q.WithPagination(limit, offset)
+	}
+
+	// Build order by
+	if orderBy, err := builder.BuildOrderBy(c, map[string]bool{
+		"email": true,
+		"name":  true,
+	}); err != nil {
+		return nil, err
+	} else if orderBy != "" {
+		q.WithOrderBy(orderBy)
+	}
+
+	// Build cursor and count options
+	if err := builder.BuildKeyset(c, &q.BaseQuery); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// FindAll overrides BaseHandler.FindAll to use buildQuery.
+func (h *UserHandler) FindAll(c *gin.Context) {
+	h.BaseHandler.FindAll(c, h.buildQuery)
+}
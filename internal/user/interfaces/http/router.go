@@ -0,0 +1,57 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+)
+
+type UserRouter struct {
+	handler       *UserHandler
+	authenticator middleware.Authenticator
+}
+
+func NewUserRouter(handler *UserHandler, authenticator middleware.Authenticator) *UserRouter {
+	return &UserRouter{
+		handler:       handler,
+		authenticator: authenticator,
+	}
+}
+
+func (r *UserRouter) Register(api *gin.RouterGroup) {
+	users := api.Group("/users", middleware.RequireAuth(r.authenticator))
+	{
+		users.POST("", r.handler.Create)
+		users.GET("", r.handler.FindAll)
+		users.GET("/:id", r.handler.FindByID)
+		users.PUT("/:id", r.handler.Update)
+		users.DELETE("/:id", r.handler.Delete)
+	}
+}
+
+type AuthRouter struct {
+	handler       *AuthHandler
+	authenticator middleware.Authenticator
+}
+
+func NewAuthRouter(handler *AuthHandler, authenticator middleware.Authenticator) *AuthRouter {
+	return &AuthRouter{
+		handler:       handler,
+		authenticator: authenticator,
+	}
+}
+
+func (r *AuthRouter) Register(api *gin.RouterGroup) {
+	authGroup := api.Group("/auth")
+	{
+		authGroup.POST("/login", r.handler.Login)
+	}
+
+	protected := authGroup.Group("", middleware.RequireAuth(r.authenticator))
+	{
+		protected.GET("/me", r.handler.Me)
+		protected.GET("/api-keys", r.handler.FindAPIKeys)
+		protected.POST("/api-keys", r.handler.CreateAPIKey)
+		protected.DELETE("/api-keys/:id", r.handler.RevokeAPIKey)
+	}
+}
@@ -0,0 +1,94 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/article/application/scheduler"
+	articleService "github.com/jambo0624/blog/internal/article/application/service"
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	articleQuery "github.com/jambo0624/blog/internal/article/domain/query"
+	"github.com/jambo0624/blog/tests/testutil"
+	"github.com/jambo0624/blog/tests/testutil/factory"
+	mockArticle "github.com/jambo0624/blog/tests/testutil/mock/article"
+	mockCategory "github.com/jambo0624/blog/tests/testutil/mock/category"
+	mockTag "github.com/jambo0624/blog/tests/testutil/mock/tag"
+)
+
+// fakeLocker grants or denies the lock without touching a database.
+type fakeLocker struct {
+	available bool
+}
+
+func (l *fakeLocker) TryWithLock(_ context.Context, _ int64, fn func() error) (bool, error) {
+	if !l.available {
+		return false, nil
+	}
+	return true, fn()
+}
+
+func setupTest(t *testing.T, lockAvailable bool) (
+	*scheduler.ScheduledPublisher,
+	*mockArticle.MockArticleRepository,
+	*mockArticle.MockScheduledPublishRunRepository,
+	*testutil.FakeClock,
+) {
+	t.Helper()
+
+	clock := testutil.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	mockArticleRepo := new(mockArticle.MockArticleRepository)
+	mockRunRepo := new(mockArticle.MockScheduledPublishRunRepository)
+	service := articleService.NewArticleService(
+		mockArticleRepo,
+		new(mockCategory.MockCategoryRepository),
+		new(mockTag.MockTagRepository),
+		new(mockArticle.MockArticleRevisionRepository),
+	).WithClock(clock)
+
+	publisher := scheduler.NewScheduledPublisher(service, mockRunRepo, &fakeLocker{available: lockAvailable}, time.Minute).
+		WithClock(clock)
+
+	return publisher, mockArticleRepo, mockRunRepo, clock
+}
+
+func TestScheduledPublisher_RunOnce(t *testing.T) {
+	publisher, mockArticleRepo, mockRunRepo, clock := setupTest(t, true)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+
+	article, _, _ := articleFactory.BuildEntity()
+	require.NoError(t, article.Schedule(clock.Now().Add(time.Minute), clock.Now()))
+	clock.Advance(2 * time.Minute)
+
+	mockArticleRepo.On("FindAll", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return q.ScheduledBefore != nil && q.ScheduledBefore.Equal(clock.Now())
+	})).Return([]*articleEntity.Article{article}, int64(1), nil)
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	mockArticleRepo.On("Update", mock.AnythingOfType("*entity.Article")).Return(nil)
+	mockRunRepo.On("Save", mock.MatchedBy(func(run *articleEntity.ScheduledPublishRun) bool {
+		return run.Published == 1 && run.Failed == 0 && run.Error == ""
+	})).Return(nil)
+
+	ran, err := publisher.RunOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.Equal(t, articleEntity.StatusPublished, article.Status)
+	assert.Equal(t, clock.Now(), *article.PublishedAt)
+	mockRunRepo.AssertExpectations(t)
+}
+
+func TestScheduledPublisher_RunOnce_LockHeldElsewhere(t *testing.T) {
+	publisher, mockArticleRepo, mockRunRepo, _ := setupTest(t, false)
+
+	ran, err := publisher.RunOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.False(t, ran)
+	mockArticleRepo.AssertNotCalled(t, "FindAll")
+	mockRunRepo.AssertNotCalled(t, "Save")
+}
@@ -1,7 +1,10 @@
 package service_test
 
 import (
+	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -9,7 +12,12 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/jambo0624/blog/internal/article/application/service"
+	"github.com/jambo0624/blog/internal/article/domain/diff"
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
 	"github.com/jambo0624/blog/internal/article/domain/query"
+	"github.com/jambo0624/blog/internal/article/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
 	factory "github.com/jambo0624/blog/tests/testutil/factory"
 	mockArticle "github.com/jambo0624/blog/tests/testutil/mock/article"
 	mockCategory "github.com/jambo0624/blog/tests/testutil/mock/category"
@@ -22,31 +30,39 @@ func setupTest(t *testing.T) (
 	*factory.ArticleFactory,
 	*mockCategory.MockCategoryRepository,
 	*mockTag.MockTagRepository,
+	*mockArticle.MockArticleRevisionRepository,
 ) {
 	t.Helper()
 
 	mockArticleRepo := new(mockArticle.MockArticleRepository)
 	mockCategoryRepo := new(mockCategory.MockCategoryRepository)
 	mockTagRepo := new(mockTag.MockTagRepository)
-	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo)
+	mockRevisionRepo := new(mockArticle.MockArticleRevisionRepository)
+	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo, mockRevisionRepo)
 	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
 
-	return mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo
+	return mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, mockRevisionRepo
 }
 
 func TestArticleService_Create(t *testing.T) {
-	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo := setupTest(t)
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, mockRevisionRepo := setupTest(t)
 
 	req, category, tag := articleFactory.BuildCreateRequest()
 
 	// Setup expectations
 	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
 	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+	mockArticleRepo.On("SlugTaken", mock.AnythingOfType("string"), uint(0)).Return(false, nil)
 	mockArticleRepo.On("Save", mock.AnythingOfType("*entity.Article")).Return(nil)
+	mockRevisionRepo.On("LatestNumber", mock.AnythingOfType("uint")).Return(uint(0), nil)
+	mockRevisionRepo.On("Save", mock.MatchedBy(func(r *articleEntity.ArticleRevision) bool {
+		return r.Number == 1 && r.Title == req.Title
+	})).Return(nil)
 
-	article, err := articleService.Create(req)
+	article, err := articleService.Create(context.Background(), req)
 
 	require.NoError(t, err)
+	mockRevisionRepo.AssertExpectations(t)
 	assert.NotNil(t, article)
 	assert.Equal(t, req.Title, article.Title)
 	assert.Equal(t, req.Content, article.Content)
@@ -55,15 +71,177 @@ func TestArticleService_Create(t *testing.T) {
 	assert.Equal(t, tag.ID, article.Tags[0].ID)
 }
 
+func TestArticleService_Create_NumbersTakenSlug(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, mockRevisionRepo := setupTest(t)
+
+	req, category, tag := articleFactory.BuildCreateRequest(func(r *dto.CreateArticleRequest) {
+		r.Title = "Hello World"
+	})
+
+	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
+	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+	mockArticleRepo.On("SlugTaken", "hello-world", uint(0)).Return(true, nil)
+	mockArticleRepo.On("SlugTaken", "hello-world-2", uint(0)).Return(true, nil)
+	mockArticleRepo.On("SlugTaken", "hello-world-3", uint(0)).Return(false, nil)
+	mockArticleRepo.On("Save", mock.AnythingOfType("*entity.Article")).Return(nil)
+	mockRevisionRepo.On("LatestNumber", mock.AnythingOfType("uint")).Return(uint(0), nil)
+	mockRevisionRepo.On("Save", mock.AnythingOfType("*entity.ArticleRevision")).Return(nil)
+
+	article, err := articleService.Create(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "hello-world-3", article.Slug)
+}
+
+func TestArticleService_Create_RequestedSlugTaken(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, _ := setupTest(t)
+
+	req, category, tag := articleFactory.BuildCreateRequest(func(r *dto.CreateArticleRequest) {
+		r.Slug = "taken"
+	})
+
+	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
+	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+	mockArticleRepo.On("SlugTaken", "taken", uint(0)).Return(true, nil)
+
+	_, err := articleService.Create(context.Background(), req)
+
+	require.ErrorIs(t, err, errors.ErrSlugTaken)
+	mockArticleRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestArticleService_Update_RenamesSlug(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, mockRevisionRepo := setupTest(t)
+
+	article, _, _ := articleFactory.BuildEntity()
+	previous := article.Slug
+	req, category, tag := articleFactory.BuildUpdateRequest(func(r *dto.UpdateArticleRequest) {
+		r.Slug = "renamed"
+	})
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
+	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+	mockArticleRepo.On("SlugTaken", "renamed", article.ID).Return(false, nil)
+	mockArticleRepo.On("Update", mock.AnythingOfType("*entity.Article")).Return(nil)
+	mockArticleRepo.On("RecordSlugChange", article.ID, previous, "renamed").Return(nil)
+	mockRevisionRepo.On("LatestNumber", article.ID).Return(uint(1), nil)
+	mockRevisionRepo.On("Save", mock.AnythingOfType("*entity.ArticleRevision")).Return(nil)
+
+	updated, err := articleService.Update(context.Background(), article.ID, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", updated.Slug)
+	mockArticleRepo.AssertExpectations(t)
+}
+
+func TestArticleService_Update_InvalidSlug(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, _ := setupTest(t)
+
+	article, _, _ := articleFactory.BuildEntity()
+	req, category, tag := articleFactory.BuildUpdateRequest(func(r *dto.UpdateArticleRequest) {
+		r.Slug = "Not A Slug"
+	})
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
+	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+
+	_, err := articleService.Update(context.Background(), article.ID, req)
+
+	require.ErrorIs(t, err, errors.ErrInvalidSlug)
+	mockArticleRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestArticleService_FindBySlug_Previous(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, _, _, _ := setupTest(t)
+
+	article, _, _ := articleFactory.BuildEntity()
+	mockArticleRepo.On("FindBySlug", "old-slug", []string(nil)).Return(nil, errors.ErrNotFound)
+	mockArticleRepo.On("FindByPreviousSlug", "old-slug").Return(article, nil)
+
+	found, err := articleService.FindBySlug(context.Background(), "old-slug")
+
+	require.NoError(t, err)
+	assert.Equal(t, article.ID, found.ID)
+}
+
+func TestArticleService_Authorize_Read(t *testing.T) {
+	owner := uint(7)
+	tests := []struct {
+		name      string
+		status    articleEntity.ArticleStatus
+		principal *auth.Principal
+		wantErr   error
+	}{
+		{"anonymous reads published", articleEntity.StatusPublished, nil, nil},
+		{"anonymous reads draft", articleEntity.StatusDraft, nil, errors.ErrArticleNotFound},
+		{"reader reads draft", articleEntity.StatusDraft, &auth.Principal{UserID: 1, Role: auth.RoleReader}, errors.ErrArticleNotFound},
+		{"author reads own draft", articleEntity.StatusDraft, &auth.Principal{UserID: owner, Role: auth.RoleAuthor}, nil},
+		{"author reads another's scheduled", articleEntity.StatusScheduled, &auth.Principal{UserID: 1, Role: auth.RoleAuthor}, errors.ErrArticleNotFound},
+		{"editor reads draft", articleEntity.StatusDraft, &auth.Principal{UserID: 1, Role: auth.RoleEditor}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockArticleRepo, articleService, articleFactory, _, _, _ := setupTest(t)
+			article, _, _ := articleFactory.BuildEntity(articleFactory.WithStatus(tt.status), articleFactory.WithAuthorID(owner))
+			mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+			err := articleService.Authorize(context.Background(), tt.principal, auth.ActionRead, article.ID)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestArticleService_Present(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, _, _, _ := setupTest(t)
+
+	article, _, _ := articleFactory.BuildEntity(func(a *articleEntity.Article) {
+		a.Content = "# Intro\n\nFirst *draft*"
+		a.Version = 1
+	})
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+	// loading alone does not render
+	found, err := articleService.FindByID(context.Background(), article.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.Rendered)
+
+	articleService.Present(found)
+	require.NotNil(t, found.Rendered)
+	assert.Contains(t, found.Rendered.HTML, "<em>draft</em>")
+	assert.Equal(t, "intro", found.Rendered.TOC[0].ID)
+	rendered := found.Rendered
+
+	// served from the cache while the version is unchanged
+	again := *article
+	again.Rendered = nil
+	articleService.Present(&again)
+	assert.Same(t, rendered, again.Rendered)
+
+	// a new version is rendered afresh
+	updated := *article
+	updated.Content = "Second **draft**"
+	updated.Version = 2
+	updated.Rendered = nil
+	articleService.Present(&updated)
+	assert.Contains(t, updated.Rendered.HTML, "<strong>draft</strong>")
+}
+
 func TestArticleService_FindAll(t *testing.T) {
-	mockArticleRepo, articleService, articleFactory, _, _ := setupTest(t)
+	mockArticleRepo, articleService, articleFactory, _, _, _ := setupTest(t)
 
 	articles := articleFactory.BuildList(2)
 
 	q := query.NewArticleQuery()
 	mockArticleRepo.On("FindAll", q).Return(articles, int64(2), nil)
 
-	found, total, err := articleService.FindAll(q)
+	found, total, err := articleService.FindAll(context.Background(), q)
 
 	require.NoError(t, err)
 	assert.Equal(t, int64(2), total)
@@ -72,7 +250,7 @@ func TestArticleService_FindAll(t *testing.T) {
 }
 
 func TestArticleService_Update(t *testing.T) {
-	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo := setupTest(t)
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, mockRevisionRepo := setupTest(t)
 
 	article, _, _ := articleFactory.BuildEntity()
 	req, category, tag := articleFactory.BuildUpdateRequest()
@@ -81,10 +259,15 @@ func TestArticleService_Update(t *testing.T) {
 	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
 	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
 	mockArticleRepo.On("Update", mock.AnythingOfType("*entity.Article")).Return(nil)
+	mockRevisionRepo.On("LatestNumber", article.ID).Return(uint(2), nil)
+	mockRevisionRepo.On("Save", mock.MatchedBy(func(r *articleEntity.ArticleRevision) bool {
+		return r.Number == 3 && r.Title == req.Title && r.Content == req.Content
+	})).Return(nil)
 
-	updated, err := articleService.Update(article.ID, req)
+	updated, err := articleService.Update(context.Background(), article.ID, req)
 
 	require.NoError(t, err)
+	mockRevisionRepo.AssertExpectations(t)
 	assert.Equal(t, req.Title, updated.Title)
 	assert.Equal(t, req.Content, updated.Content)
 	assert.Equal(t, category.ID, updated.CategoryID)
@@ -93,18 +276,18 @@ func TestArticleService_Update(t *testing.T) {
 }
 
 func TestArticleService_Delete(t *testing.T) {
-	mockArticleRepo, articleService, _, _, _ := setupTest(t)
+	mockArticleRepo, articleService, _, _, _, _ := setupTest(t)
 
 	mockArticleRepo.On("Delete", mock.AnythingOfType("uint")).Return(nil)
 
-	err := articleService.Delete(1)
+	err := articleService.Delete(context.Background(), 1)
 
 	require.NoError(t, err)
 	mockArticleRepo.AssertExpectations(t)
 }
 
 func TestArticleService_Create_ValidationError(t *testing.T) {
-	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo := setupTest(t)
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, _ := setupTest(t)
 
 	req, category, tag := articleFactory.BuildCreateRequest()
 	req.Title = "" // invalid title
@@ -114,7 +297,7 @@ func TestArticleService_Create_ValidationError(t *testing.T) {
 	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
 	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
 
-	article, err := articleService.Create(req)
+	article, err := articleService.Create(context.Background(), req)
 
 	require.Error(t, err)
 	assert.Nil(t, article)
@@ -122,12 +305,12 @@ func TestArticleService_Create_ValidationError(t *testing.T) {
 }
 
 func TestArticleService_Update_NotFound(t *testing.T) {
-	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo := setupTest(t)
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, _ := setupTest(t)
 
 	mockArticleRepo.On("FindByID", uint(999), mock.Anything).Return(nil, gorm.ErrRecordNotFound)
 
 	req, _, _ := articleFactory.BuildUpdateRequest()
-	article, err := articleService.Update(999, req)
+	article, err := articleService.Update(context.Background(), 999, req)
 
 	require.Error(t, err)
 	assert.Nil(t, article)
@@ -135,3 +318,119 @@ func TestArticleService_Update_NotFound(t *testing.T) {
 	mockCategoryRepo.AssertNotCalled(t, "FindByID")
 	mockTagRepo.AssertNotCalled(t, "FindByID")
 }
+
+func TestArticleService_Update_VersionConflict(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, _, mockRevisionRepo := setupTest(t)
+
+	article, _, _ := articleFactory.BuildEntity()
+	article.Version = 3
+	req, _, _ := articleFactory.BuildUpdateRequest()
+	req.SetIfMatch(2)
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+	updated, err := articleService.Update(context.Background(), article.ID, req)
+
+	require.ErrorIs(t, err, errors.ErrVersionConflict)
+	assert.Nil(t, updated)
+	mockArticleRepo.AssertNotCalled(t, "Update", mock.Anything)
+	mockCategoryRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	mockRevisionRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestArticleService_Publish(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, _, _, _ := setupTest(t)
+
+	article, _, _ := articleFactory.BuildEntity()
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	mockArticleRepo.On("Update", mock.AnythingOfType("*entity.Article")).Return(nil)
+
+	published, err := articleService.Publish(context.Background(), article.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, articleEntity.StatusPublished, published.Status)
+	assert.NotNil(t, published.PublishedAt)
+}
+
+func TestArticleService_Schedule_InPast(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, _, _, _ := setupTest(t)
+
+	article, _, _ := articleFactory.BuildEntity()
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+	req := &dto.ScheduleArticleRequest{ScheduledFor: time.Now().Add(-time.Hour)}
+	scheduled, err := articleService.Schedule(context.Background(), article.ID, req)
+
+	require.ErrorIs(t, err, errors.ErrScheduleInPast)
+	assert.Nil(t, scheduled)
+	mockArticleRepo.AssertNotCalled(t, "Update")
+}
+
+func TestArticleService_DiffRevisions(t *testing.T) {
+	_, articleService, _, _, _, mockRevisionRepo := setupTest(t)
+
+	mockRevisionRepo.On("FindByNumber", uint(1), uint(1)).
+		Return(&articleEntity.ArticleRevision{ArticleID: 1, Number: 1, Title: "Title", Content: "a\nb\nc"}, nil)
+	mockRevisionRepo.On("FindByNumber", uint(1), uint(2)).
+		Return(&articleEntity.ArticleRevision{ArticleID: 1, Number: 2, Title: "Title", Content: "a\nc\nd"}, nil)
+
+	result, err := articleService.DiffRevisions(context.Background(), 1, 1, 2)
+
+	require.NoError(t, err)
+	assert.True(t, result.Changed)
+	assert.Equal(t, []diff.Line{
+		{Op: diff.OpEqual, Text: "a"},
+		{Op: diff.OpDelete, Text: "b"},
+		{Op: diff.OpEqual, Text: "c"},
+		{Op: diff.OpInsert, Text: "d"},
+	}, result.Content)
+}
+
+func TestArticleService_DiffRevisions_TooLarge(t *testing.T) {
+	_, articleService, _, _, _, mockRevisionRepo := setupTest(t)
+
+	huge := strings.Repeat("line\n", 2000)
+	mockRevisionRepo.On("FindByNumber", uint(1), uint(1)).
+		Return(&articleEntity.ArticleRevision{ArticleID: 1, Number: 1, Title: "Title", Content: huge}, nil)
+	mockRevisionRepo.On("FindByNumber", uint(1), uint(2)).
+		Return(&articleEntity.ArticleRevision{ArticleID: 1, Number: 2, Title: "Title", Content: huge + "more"}, nil)
+
+	_, err := articleService.DiffRevisions(context.Background(), 1, 1, 2)
+
+	require.ErrorIs(t, err, errors.ErrDiffTooLarge)
+}
+
+// spyUnitOfWork records the outcome of the work it runs.
+type spyUnitOfWork struct {
+	calls int
+	err   error
+}
+
+func (u *spyUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	u.calls++
+	u.err = fn(ctx)
+	return u.err
+}
+
+func TestArticleService_Create_RevisionFailureRollsBack(t *testing.T) {
+	mockArticleRepo, articleService, articleFactory, mockCategoryRepo, mockTagRepo, mockRevisionRepo := setupTest(t)
+	uow := &spyUnitOfWork{}
+	articleService.WithUnitOfWork(uow)
+
+	req, category, tag := articleFactory.BuildCreateRequest()
+
+	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
+	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+	mockArticleRepo.On("SlugTaken", mock.AnythingOfType("string"), uint(0)).Return(false, nil)
+	mockArticleRepo.On("Save", mock.AnythingOfType("*entity.Article")).Return(nil)
+	mockRevisionRepo.On("LatestNumber", mock.AnythingOfType("uint")).Return(uint(0), nil)
+	mockRevisionRepo.On("Save", mock.AnythingOfType("*entity.ArticleRevision")).Return(gorm.ErrInvalidDB)
+
+	article, err := articleService.Create(context.Background(), req)
+
+	require.ErrorIs(t, err, gorm.ErrInvalidDB)
+	assert.Nil(t, article)
+	assert.Equal(t, 1, uow.calls)
+	assert.ErrorIs(t, uow.err, gorm.ErrInvalidDB, "the failure must reach the unit of work so it rolls back")
+}
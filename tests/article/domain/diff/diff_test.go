@@ -0,0 +1,135 @@
+package diff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/article/domain/diff"
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		want    []diff.Line
+		changed bool
+	}{
+		{
+			name: "equal",
+			from: "a\nb",
+			to:   "a\nb",
+			want: []diff.Line{{Op: diff.OpEqual, Text: "a"}, {Op: diff.OpEqual, Text: "b"}},
+		},
+		{
+			name: "insert",
+			from: "a\nc",
+			to:   "a\nb\nc",
+			want: []diff.Line{
+				{Op: diff.OpEqual, Text: "a"},
+				{Op: diff.OpInsert, Text: "b"},
+				{Op: diff.OpEqual, Text: "c"},
+			},
+			changed: true,
+		},
+		{
+			name: "delete",
+			from: "a\nb\nc",
+			to:   "a\nc",
+			want: []diff.Line{
+				{Op: diff.OpEqual, Text: "a"},
+				{Op: diff.OpDelete, Text: "b"},
+				{Op: diff.OpEqual, Text: "c"},
+			},
+			changed: true,
+		},
+		{
+			name: "replace",
+			from: "a\nb",
+			to:   "a\nc",
+			want: []diff.Line{
+				{Op: diff.OpEqual, Text: "a"},
+				{Op: diff.OpDelete, Text: "b"},
+				{Op: diff.OpInsert, Text: "c"},
+			},
+			changed: true,
+		},
+		{
+			name: "crlf matches lf",
+			from: "a\r\nb",
+			to:   "a\nb",
+			want: []diff.Line{{Op: diff.OpEqual, Text: "a"}, {Op: diff.OpEqual, Text: "b"}},
+		},
+		{
+			name:    "empty from",
+			from:    "",
+			to:      "a\nb",
+			want:    []diff.Line{{Op: diff.OpInsert, Text: "a"}, {Op: diff.OpInsert, Text: "b"}},
+			changed: true,
+		},
+		{
+			name:    "empty to",
+			from:    "a\nb",
+			to:      "",
+			want:    []diff.Line{{Op: diff.OpDelete, Text: "a"}, {Op: diff.OpDelete, Text: "b"}},
+			changed: true,
+		},
+		{
+			name: "both empty",
+			from: "",
+			to:   "",
+			want: []diff.Line{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := diff.Lines(tt.from, tt.to)
+			assert.Equal(t, tt.want, lines)
+			assert.Equal(t, tt.changed, diff.HasChanges(lines))
+		})
+	}
+}
+
+func TestCompareRevisions(t *testing.T) {
+	from := &articleEntity.ArticleRevision{ArticleID: 1, Number: 1, Title: "Title", Content: "a\nb"}
+	to := &articleEntity.ArticleRevision{ArticleID: 1, Number: 2, Title: "Title", Content: "a\nc"}
+
+	result, err := diff.CompareRevisions(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, &diff.RevisionDiff{
+		ArticleID: 1,
+		From:      1,
+		To:        2,
+		Title:     []diff.Line{{Op: diff.OpEqual, Text: "Title"}},
+		Content: []diff.Line{
+			{Op: diff.OpEqual, Text: "a"},
+			{Op: diff.OpDelete, Text: "b"},
+			{Op: diff.OpInsert, Text: "c"},
+		},
+		Changed: true,
+	}, result)
+}
+
+func TestCompareRevisions_TooLarge(t *testing.T) {
+	// 1024 lines on each side fill MaxCells exactly, one more is refused.
+	atLimit := strings.Repeat("line\n", 1023) + "line"
+	overLimit := atLimit + "\nline"
+
+	_, err := diff.CompareRevisions(
+		&articleEntity.ArticleRevision{Content: atLimit},
+		&articleEntity.ArticleRevision{Content: atLimit},
+	)
+	require.NoError(t, err)
+
+	_, err = diff.CompareRevisions(
+		&articleEntity.ArticleRevision{Content: overLimit},
+		&articleEntity.ArticleRevision{Content: overLimit},
+	)
+	require.ErrorIs(t, err, errors.ErrDiffTooLarge)
+}
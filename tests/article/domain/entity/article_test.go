@@ -2,6 +2,7 @@ package entity_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -62,7 +63,7 @@ func TestNewArticle(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			article, err := entity.NewArticle(tt.category, tt.title, tt.content, tt.tags)
+			article, err := entity.NewArticle(tt.category, tt.title, tt.content, tt.tags, time.Now())
 			if tt.wantErr {
 				assert.ErrorIs(t, err, tt.expectedErr)
 				return
@@ -80,7 +81,7 @@ func TestNewArticle(t *testing.T) {
 
 func TestArticle_AddTag(t *testing.T) {
 	category, _ := categoryEntity.NewCategory("Test Category", "test-category")
-	article, _ := entity.NewArticle(category, "Test Title", "Test Content", nil)
+	article, _ := entity.NewArticle(category, "Test Title", "Test Content", nil, time.Now())
 	tag, _ := tagEntity.NewTag("Test Tag", "#FF0000")
 
 	tests := []struct {
@@ -127,7 +128,7 @@ func TestArticle_AddTag(t *testing.T) {
 func TestArticle_Update(t *testing.T) {
 	category, _ := categoryEntity.NewCategory("Original Category", "original-category")
 	tag, _ := tagEntity.NewTag("Original Tag", "#000000")
-	article, _ := entity.NewArticle(category, "Original Title", "Original Content", []tagEntity.Tag{*tag})
+	article, _ := entity.NewArticle(category, "Original Title", "Original Content", []tagEntity.Tag{*tag}, time.Now())
 
 	newCategory, _ := categoryEntity.NewCategory("New Category", "new-category")
 	newTag, _ := tagEntity.NewTag("New Tag", "#FFFFFF")
@@ -139,10 +140,101 @@ func TestArticle_Update(t *testing.T) {
 		TagIDs:     []uint{newTag.ID},
 	}
 
-	article.Update(req, newCategory, []tagEntity.Tag{*newTag})
+	updatedAt := time.Now()
+	article.Update(req, newCategory, []tagEntity.Tag{*newTag}, updatedAt)
 
 	assert.Equal(t, "Updated Title", article.Title)
 	assert.Equal(t, "Updated Content", article.Content)
 	assert.Equal(t, newCategory.ID, article.CategoryID)
 	assert.Equal(t, newTag.ID, article.Tags[0].ID)
+	assert.Equal(t, updatedAt, article.UpdatedAt)
+}
+
+func TestArticle_StatusTransitions(t *testing.T) {
+	category, _ := categoryEntity.NewCategory("Test Category", "test-category")
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		setup       func(a *entity.Article)
+		transition  func(a *entity.Article) error
+		wantStatus  entity.ArticleStatus
+		expectedErr error
+	}{
+		{
+			name:       "draft to published",
+			transition: func(a *entity.Article) error { return a.Publish(now) },
+			wantStatus: entity.StatusPublished,
+		},
+		{
+			name:       "draft to in review",
+			transition: func(a *entity.Article) error { return a.SubmitForReview(now) },
+			wantStatus: entity.StatusInReview,
+		},
+		{
+			name:       "draft to scheduled",
+			transition: func(a *entity.Article) error { return a.Schedule(now.Add(time.Hour), now) },
+			wantStatus: entity.StatusScheduled,
+		},
+		{
+			name:        "schedule in the past",
+			transition:  func(a *entity.Article) error { return a.Schedule(now.Add(-time.Hour), now) },
+			wantStatus:  entity.StatusDraft,
+			expectedErr: errors.ErrScheduleInPast,
+		},
+		{
+			name:        "unpublish draft",
+			transition:  func(a *entity.Article) error { return a.Unpublish(now) },
+			wantStatus:  entity.StatusDraft,
+			expectedErr: errors.ErrInvalidStatusTransition,
+		},
+		{
+			name:       "published to draft",
+			setup:      func(a *entity.Article) { require.NoError(t, a.Publish(now)) },
+			transition: func(a *entity.Article) error { return a.Unpublish(now) },
+			wantStatus: entity.StatusDraft,
+		},
+		{
+			name:        "archived to published",
+			setup:       func(a *entity.Article) { require.NoError(t, a.Archive(now)) },
+			transition:  func(a *entity.Article) error { return a.Publish(now) },
+			wantStatus:  entity.StatusArchived,
+			expectedErr: errors.ErrInvalidStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			article, err := entity.NewArticle(category, "Test Title", "Test Content", nil, now)
+			require.NoError(t, err)
+			if tt.setup != nil {
+				tt.setup(article)
+			}
+
+			err = tt.transition(article)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStatus, article.Status)
+		})
+	}
+}
+
+func TestArticle_PublishTimestamps(t *testing.T) {
+	category, _ := categoryEntity.NewCategory("Test Category", "test-category")
+	now := time.Now()
+	article, _ := entity.NewArticle(category, "Test Title", "Test Content", nil, now)
+
+	require.NoError(t, article.Schedule(now.Add(time.Hour), now))
+	require.NotNil(t, article.ScheduledFor)
+
+	require.NoError(t, article.Publish(now))
+	require.NotNil(t, article.PublishedAt)
+	assert.Equal(t, now, *article.PublishedAt)
+	assert.Nil(t, article.ScheduledFor)
+
+	require.NoError(t, article.Unpublish(now))
+	assert.Nil(t, article.PublishedAt)
 }
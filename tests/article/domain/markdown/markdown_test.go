@@ -0,0 +1,73 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/article/domain/markdown"
+)
+
+func render(t *testing.T, source string) *markdown.Document {
+	t.Helper()
+	doc, err := markdown.NewRenderer().Render(source)
+	require.NoError(t, err)
+	return doc
+}
+
+func TestRenderer_Render(t *testing.T) {
+	doc := render(t, "Some *emphasis* and a [link](https://example.com).")
+
+	assert.Equal(t,
+		`<p>Some <em>emphasis</em> and a <a href="https://example.com" rel="nofollow">link</a>.</p>`+"\n",
+		doc.HTML)
+	assert.Empty(t, doc.TOC)
+}
+
+func TestRenderer_GFM(t *testing.T) {
+	doc := render(t, "| a | b |\n|---|---|\n| 1 | 2 |\n\n~~gone~~\n\n- [x] done\n")
+
+	assert.Contains(t, doc.HTML, "<table>")
+	assert.Contains(t, doc.HTML, "<td>1</td>")
+	assert.Contains(t, doc.HTML, "<del>gone</del>")
+	assert.Contains(t, doc.HTML, `<input checked="" disabled="" type="checkbox">`)
+}
+
+func TestRenderer_Footnotes(t *testing.T) {
+	doc := render(t, "Claim[^1].\n\n[^1]: Source.\n")
+
+	assert.Contains(t, doc.HTML, `<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref"`)
+	assert.Contains(t, doc.HTML, `<li id="fn:1">`)
+}
+
+func TestRenderer_HighlightsCode(t *testing.T) {
+	doc := render(t, "```go\nfunc main() {}\n```\n")
+
+	assert.Contains(t, doc.HTML, `<pre class="chroma">`)
+	assert.Contains(t, doc.HTML, `<span class="kd">func</span>`)
+}
+
+func TestRenderer_Sanitizes(t *testing.T) {
+	doc := render(t, "<script>alert(1)</script>\n\n"+
+		"<img src=x onerror=alert(1)>\n\n"+
+		"[click](javascript:alert(1))\n\n"+
+		`![img](https://example.com/a.png "t")`+"\n")
+
+	assert.NotContains(t, doc.HTML, "<script")
+	assert.NotContains(t, doc.HTML, "onerror")
+	assert.NotContains(t, doc.HTML, "javascript:")
+	assert.Contains(t, doc.HTML, `<img src="https://example.com/a.png" alt="img" title="t">`)
+}
+
+func TestRenderer_TableOfContents(t *testing.T) {
+	doc := render(t, "# Getting *started*\n\ntext\n\n## Install `blog`\n\n## Install `blog`\n\n### Next\n")
+
+	assert.Equal(t, []markdown.Heading{
+		{Level: 1, ID: "getting-started", Title: "Getting started"},
+		{Level: 2, ID: "install-blog", Title: "Install blog"},
+		{Level: 2, ID: "install-blog-1", Title: "Install blog"},
+		{Level: 3, ID: "next", Title: "Next"},
+	}, doc.TOC)
+	assert.Contains(t, doc.HTML, `<h2 id="install-blog-1">`)
+}
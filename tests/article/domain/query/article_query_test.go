@@ -49,6 +49,15 @@ func TestArticleQuery_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid status",
+			query: func() *query.ArticleQuery {
+				q := query.NewArticleQuery()
+				q.WithStatuses("unknown")
+				return q
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid limit",
 			query: func() *query.ArticleQuery {
@@ -101,6 +110,20 @@ func TestArticleQuery_ApplyFilters(t *testing.T) {
 				"category_id = 1",
 			},
 		},
+		{
+			name: "with category and descendants filter",
+			setupQuery: func() *query.ArticleQuery {
+				q := query.NewArticleQuery()
+				q.WithCategoryID(1)
+				q.WithDescendants()
+				return q
+			},
+			expectedClauses: []string{
+				"category_id IN (",
+				"WITH RECURSIVE subtree AS",
+				"c.parent_id = s.id",
+			},
+		},
 		{
 			name: "with tag filter",
 			setupQuery: func() *query.ArticleQuery {
@@ -113,6 +136,17 @@ func TestArticleQuery_ApplyFilters(t *testing.T) {
 				"article_tags.tag_id IN (1,2)",
 			},
 		},
+		{
+			name: "with author filter",
+			setupQuery: func() *query.ArticleQuery {
+				q := query.NewArticleQuery()
+				q.WithAuthorID(7)
+				return q
+			},
+			expectedClauses: []string{
+				"articles.author_id = 7",
+			},
+		},
 		{
 			name: "with title filter",
 			setupQuery: func() *query.ArticleQuery {
@@ -135,6 +169,28 @@ func TestArticleQuery_ApplyFilters(t *testing.T) {
 				"content LIKE '%test%'",
 			},
 		},
+		{
+			name: "with published only filter",
+			setupQuery: func() *query.ArticleQuery {
+				q := query.NewArticleQuery()
+				q.WithPublishedOnly()
+				return q
+			},
+			expectedClauses: []string{
+				"articles.status IN ('published')",
+			},
+		},
+		{
+			name: "with search filter",
+			setupQuery: func() *query.ArticleQuery {
+				q := query.NewArticleQuery()
+				q.WithSearch("golang & ddd")
+				return q
+			},
+			expectedClauses: []string{
+				"articles.search_vector @@ to_tsquery('english', 'golang & ddd')",
+			},
+		},
 		{
 			name: "with multiple filters",
 			setupQuery: func() *query.ArticleQuery {
@@ -0,0 +1,68 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/article/domain/query"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+func TestToTSQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  error
+	}{
+		{
+			name:     "single term",
+			input:    "golang",
+			expected: "golang",
+		},
+		{
+			name:     "terms are anded and lowercased",
+			input:    "Domain Driven",
+			expected: "domain & driven",
+		},
+		{
+			name:     "prefix term",
+			input:    "micro*",
+			expected: "micro:*",
+		},
+		{
+			name:     "phrase",
+			input:    `"domain driven design" go`,
+			expected: "(domain <-> driven <-> design) & go",
+		},
+		{
+			name:     "or and negation",
+			input:    "go OR rust -java",
+			expected: "go | rust & !java",
+		},
+		{
+			name:     "tsquery operators are stripped",
+			input:    "go&(rust|!java):",
+			expected: "gorustjava",
+		},
+		{
+			name:    "no searchable terms",
+			input:   `"" & !`,
+			wantErr: errors.ErrInvalidSearchQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := query.ToTSQuery(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
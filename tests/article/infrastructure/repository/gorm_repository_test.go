@@ -1,10 +1,13 @@
 package repository_test
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 
 	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
 	articleQuery "github.com/jambo0624/blog/internal/article/domain/query"
@@ -32,7 +35,7 @@ func TestGormArticleRepository_FindByID(t *testing.T) {
 
 	article := testDB.Data.Articles[0]
 
-	found, err := repo.FindByID(article.ID)
+	found, err := repo.FindByID(context.Background(), article.ID)
 	require.NoError(t, err)
 	assert.Equal(t, article.Title, found.Title)
 	assert.Equal(t, article.Content, found.Content)
@@ -47,7 +50,7 @@ func TestGormArticleRepository_FindAll(t *testing.T) {
 		q := articleQuery.NewArticleQuery()
 		q.WithCategoryID(testDB.Data.Categories[0].ID)
 
-		articles, total, err := repo.FindAll(q)
+		articles, total, err := repo.FindAll(context.Background(), q)
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Equal(t, testDB.Data.Articles[0].Title, articles[0].Title)
@@ -58,7 +61,7 @@ func TestGormArticleRepository_FindAll(t *testing.T) {
 		q.WithTagIDs([]uint{testDB.Data.Tags[0].ID})
 		q.PreloadAssociations = []string{"Tags"}
 
-		articles, total, err := repo.FindAll(q)
+		articles, total, err := repo.FindAll(context.Background(), q)
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, articles, 1)
@@ -84,13 +87,13 @@ func TestGormArticleRepository_Save(t *testing.T) {
 	require.NoError(t, err)
 
 	// Save Article
-	err = repo.Save(article)
+	err = repo.Save(context.Background(), article)
 	require.NoError(t, err)
 	require.NotZero(t, article.ID)
 
 	// Verify saved article
 	preloads := []string{"Tags", "Category"}
-	found, err := repo.FindByID(article.ID, preloads...)
+	found, err := repo.FindByID(context.Background(), article.ID, preloads...)
 	require.NoError(t, err)
 	assert.Equal(t, article.Title, found.Title)
 	assert.Equal(t, article.Content, found.Content)
@@ -106,10 +109,10 @@ func TestGormArticleRepository_Update(t *testing.T) {
 	article := testDB.Data.Articles[0]
 
 	article.Title = "Updated Title"
-	err := repo.Update(article)
+	err := repo.Update(context.Background(), article)
 	require.NoError(t, err)
 
-	found, err := repo.FindByID(article.ID)
+	found, err := repo.FindByID(context.Background(), article.ID)
 	require.NoError(t, err)
 	assert.Equal(t, "Updated Title", found.Title)
 }
@@ -120,16 +123,42 @@ func TestGormArticleRepository_Delete(t *testing.T) {
 
 	article := testDB.Data.Articles[0]
 
-	err := repo.Delete(article.ID)
+	err := repo.Delete(context.Background(), article.ID)
 	require.NoError(t, err)
 
 	var found *articleEntity.Article
 	err = testDB.DB.Unscoped().First(&found, article.ID).Error
 	require.NoError(t, err)
-	require.NotNil(t, found.DeletedAt)
+	require.True(t, found.DeletedAt.Valid)
 
-	_, err = repo.FindByID(article.ID)
+	_, err = repo.FindByID(context.Background(), article.ID)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestGormArticleRepository_Search_EscapesHighlights(t *testing.T) {
+	testDB, cleanup, repo, _ := setupTest(t)
+	defer cleanup()
+
+	article := testDB.Data.Articles[0]
+	article.Title = `<img src=x onerror=alert(1)> Zanzibar`
+	article.Content = `<script>alert("xss")</script> Zanzibar is an archipelago.`
+	require.NoError(t, repo.Update(context.Background(), article))
+
+	q := articleQuery.NewArticleQuery()
+	q.WithSearch("zanzibar")
+
+	results, total, err := repo.Search(context.Background(), q)
 	require.NoError(t, err)
+	require.Equal(t, int64(1), total)
+	require.Len(t, results, 1)
+
+	highlights := results[0].Highlights
+	for _, snippet := range []string{highlights.Title, highlights.Content} {
+		assert.Contains(t, snippet, "<mark>Zanzibar</mark>")
+		unmarked := strings.NewReplacer("<mark>", "", "</mark>", "").Replace(snippet)
+		assert.NotContains(t, unmarked, "<")
+		assert.NotContains(t, unmarked, ">")
+	}
 }
 
 // buildTestCase is a helper function to create test cases.
@@ -316,7 +345,7 @@ func TestGormArticleRepository_FindAll_WithFilters(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			articles, count, err := repo.FindAll(tt.buildQuery())
+			articles, count, err := repo.FindAll(context.Background(), tt.buildQuery())
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedCount, count)
 			if tt.validate != nil {
@@ -325,3 +354,77 @@ func TestGormArticleRepository_FindAll_WithFilters(t *testing.T) {
 		})
 	}
 }
+
+func TestGormArticleRepository_Purge(t *testing.T) {
+	testDB, cleanup, repo, _ := setupTest(t)
+	defer cleanup()
+
+	article := testDB.Data.Articles[0]
+
+	require.NoError(t, repo.Delete(context.Background(), article.ID))
+	require.NoError(t, repo.Purge(context.Background(), article.ID))
+
+	var count int64
+	testDB.DB.Unscoped().Model(&articleEntity.Article{}).Where("id = ?", article.ID).Count(&count)
+	assert.Zero(t, count)
+	testDB.DB.Table("article_tags").Where("article_id = ?", article.ID).Count(&count)
+	assert.Zero(t, count)
+}
+
+func TestGormArticleRepository_SlugHistory(t *testing.T) {
+	testDB, cleanup, repo, _ := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	article := testDB.Data.Articles[0]
+	other := testDB.Data.Articles[1]
+
+	found, err := repo.FindBySlug(ctx, "test-article-1")
+	require.NoError(t, err)
+	assert.Equal(t, article.ID, found.ID)
+
+	article.Slug = "renamed"
+	require.NoError(t, repo.Update(ctx, article))
+	require.NoError(t, repo.RecordSlugChange(ctx, article.ID, "test-article-1", "renamed"))
+
+	found, err = repo.FindByPreviousSlug(ctx, "test-article-1")
+	require.NoError(t, err)
+	assert.Equal(t, article.ID, found.ID)
+
+	taken, err := repo.SlugTaken(ctx, "test-article-1", other.ID)
+	require.NoError(t, err)
+	assert.True(t, taken, "previous slugs stay reserved for their article")
+	taken, err = repo.SlugTaken(ctx, "test-article-1", article.ID)
+	require.NoError(t, err)
+	assert.False(t, taken)
+
+	// renaming back releases the slug from the history
+	article.Slug = "test-article-1"
+	require.NoError(t, repo.Update(ctx, article))
+	require.NoError(t, repo.RecordSlugChange(ctx, article.ID, "renamed", "test-article-1"))
+	_, err = repo.FindByPreviousSlug(ctx, "test-article-1")
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestGormArticleRepository_FindPublishedLinks(t *testing.T) {
+	testDB, cleanup, repo, _ := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	published, trashed := testDB.Data.Articles[0], testDB.Data.Articles[1]
+	for _, article := range []*articleEntity.Article{published, trashed} {
+		require.NoError(t, testDB.DB.Model(article).UpdateColumn("status", articleEntity.StatusPublished).Error)
+	}
+	require.NoError(t, repo.Delete(ctx, trashed.ID))
+
+	links, err := repo.FindPublishedLinks(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, published.ID, links[0].ID)
+	assert.Equal(t, published.Slug, links[0].Slug)
+	assert.False(t, links[0].UpdatedAt.IsZero())
+
+	total, err := repo.CountPublished(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+}
@@ -0,0 +1,129 @@
+package feed_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/article/interfaces/http/feed"
+)
+
+func sampleFeed() feed.Feed {
+	published := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	return feed.Feed{
+		Title:       "Blog",
+		Description: "Notes",
+		Link:        "https://example.com/",
+		Self:        "https://example.com/feed.xml",
+		Updated:     published,
+		Items: []feed.Item{{
+			ID:         "https://example.com/articles/1",
+			Title:      "Hello <World>",
+			Link:       "https://example.com/articles/1",
+			Author:     "Ada",
+			Summary:    "Short",
+			Content:    "Full & complete",
+			Categories: []string{"Go"},
+			Published:  published,
+			Updated:    published,
+		}},
+	}
+}
+
+func TestRSS(t *testing.T) {
+	body, err := feed.RSS(sampleFeed())
+	require.NoError(t, err)
+
+	var doc struct {
+		Version string `xml:"version,attr"`
+		Items   []struct {
+			Title   string `xml:"title"`
+			GUID    string `xml:"guid"`
+			PubDate string `xml:"pubDate"`
+			Content string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+		} `xml:"channel>item"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc))
+	assert.Equal(t, "2.0", doc.Version)
+	require.Len(t, doc.Items, 1)
+	assert.Equal(t, "Hello <World>", doc.Items[0].Title)
+	assert.Equal(t, "https://example.com/articles/1", doc.Items[0].GUID)
+	assert.Equal(t, "Wed, 01 May 2024 12:00:00 GMT", doc.Items[0].PubDate)
+	assert.Equal(t, "Full & complete", doc.Items[0].Content)
+}
+
+func TestAtom(t *testing.T) {
+	body, err := feed.Atom(sampleFeed())
+	require.NoError(t, err)
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			ID      string `xml:"id"`
+			Author  string `xml:"author>name"`
+			Content string `xml:"content"`
+		} `xml:"entry"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc))
+	assert.Equal(t, "2024-05-01T12:00:00Z", doc.Updated)
+	require.Len(t, doc.Entries, 1)
+	assert.Equal(t, "Ada", doc.Entries[0].Author)
+	assert.Equal(t, "Full & complete", doc.Entries[0].Content)
+}
+
+func TestJSON_SummaryMode(t *testing.T) {
+	f := sampleFeed()
+	f.Items[0].Content = ""
+
+	body, err := feed.JSON(f)
+	require.NoError(t, err)
+
+	var doc struct {
+		Version string `json:"version"`
+		Items   []struct {
+			ContentText string `json:"content_text"`
+			Summary     string `json:"summary"`
+		} `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(body, &doc))
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", doc.Version)
+	require.Len(t, doc.Items, 1)
+	assert.Equal(t, "Short", doc.Items[0].ContentText)
+	assert.Equal(t, "Short", doc.Items[0].Summary)
+}
+
+func TestSummarize(t *testing.T) {
+	assert.Equal(t, "one two", feed.Summarize("  one\n\ntwo "))
+
+	long := strings.Repeat("word ", 100)
+	summary := feed.Summarize(long)
+	assert.True(t, strings.HasSuffix(summary, "word…"))
+	assert.LessOrEqual(t, len([]rune(summary)), 281)
+}
+
+func TestAtom_HTMLContent(t *testing.T) {
+	f := sampleFeed()
+	f.Items[0].ContentHTML = "<p>Full &amp; complete</p>"
+
+	body, err := feed.Atom(f)
+	require.NoError(t, err)
+
+	var doc struct {
+		Entries []struct {
+			Content struct {
+				Type  string `xml:"type,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"content"`
+		} `xml:"entry"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc))
+	require.Len(t, doc.Entries, 1)
+	assert.Equal(t, "html", doc.Entries[0].Content.Type)
+	assert.Equal(t, "<p>Full &amp; complete</p>", doc.Entries[0].Content.Value)
+}
@@ -0,0 +1,167 @@
+package http_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	articleService "github.com/jambo0624/blog/internal/article/application/service"
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	articleQuery "github.com/jambo0624/blog/internal/article/domain/query"
+	articleHandler "github.com/jambo0624/blog/internal/article/interfaces/http"
+	"github.com/jambo0624/blog/internal/article/interfaces/http/feed"
+	categoryService "github.com/jambo0624/blog/internal/category/application/service"
+	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
+	categoryQuery "github.com/jambo0624/blog/internal/category/domain/query"
+	tagService "github.com/jambo0624/blog/internal/tag/application/service"
+	"github.com/jambo0624/blog/tests/testutil"
+	"github.com/jambo0624/blog/tests/testutil/factory"
+	mockArticle "github.com/jambo0624/blog/tests/testutil/mock/article"
+	mockCategory "github.com/jambo0624/blog/tests/testutil/mock/category"
+	mockTag "github.com/jambo0624/blog/tests/testutil/mock/tag"
+)
+
+func setupFeedTest(t *testing.T) (
+	*testutil.HTTPTester,
+	*mockArticle.MockArticleRepository,
+	*mockCategory.MockCategoryRepository,
+	*mockTag.MockTagRepository,
+) {
+	t.Helper()
+	mockArticleRepo := new(mockArticle.MockArticleRepository)
+	mockCategoryRepo := new(mockCategory.MockCategoryRepository)
+	mockTagRepo := new(mockTag.MockTagRepository)
+
+	handler := articleHandler.NewFeedHandler(
+		articleService.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo, new(mockArticle.MockArticleRevisionRepository)),
+		categoryService.NewCategoryService(mockCategoryRepo),
+		tagService.NewTagService(mockTagRepo),
+		articleHandler.FeedOptions{
+			SiteURL: "https://example.com",
+			Title:   "Blog",
+			Limit:   10,
+			Content: articleHandler.FeedContentFull,
+		},
+	)
+	tester := testutil.NewHTTPTester(t, articleHandler.NewFeedRouter(handler).Register)
+
+	return tester, mockArticleRepo, mockCategoryRepo, mockTagRepo
+}
+
+func publishedArticles() []*articleEntity.Article {
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	articles := articleFactory.BuildList(2)
+	publishedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	for _, article := range articles {
+		article.Status = articleEntity.StatusPublished
+		article.PublishedAt = &publishedAt
+		article.UpdatedAt = publishedAt
+	}
+	return articles
+}
+
+func TestFeedHandler_Site(t *testing.T) {
+	tester, mockArticleRepo, _, _ := setupFeedTest(t)
+	articles := publishedArticles()
+
+	mockArticleRepo.On("FindAll", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return len(q.Statuses) == 1 && q.Statuses[0] == articleQuery.StatusPublished &&
+			q.Limit == 10 && q.OrderBy == "-published_at"
+	})).Return(articles, int64(0), nil)
+
+	for _, tt := range []struct {
+		path        string
+		contentType string
+	}{
+		{"/api/feed.xml", feed.ContentTypeRSS},
+		{"/api/atom.xml", feed.ContentTypeAtom},
+		{"/api/feed.json", feed.ContentTypeJSON},
+	} {
+		body := tester.
+			Get(tt.path, nil).
+			SeeStatus(http.StatusOK).
+			SeeHeader("Content-Type", tt.contentType).
+			SeeHeader("Last-Modified", "Wed, 01 May 2024 12:00:00 GMT").
+			Body()
+		require.Contains(t, body, "https://example.com/articles/"+articles[0].Slug)
+	}
+}
+
+func TestFeedHandler_NotModified(t *testing.T) {
+	tester, mockArticleRepo, _, _ := setupFeedTest(t)
+
+	// every request loads its own copies, as the repository does
+	for range 3 {
+		mockArticleRepo.On("FindAll", mock.AnythingOfType("*query.ArticleQuery")).
+			Return(publishedArticles(), int64(0), nil).Once()
+	}
+
+	etag := tester.
+		Get("/api/feed.xml", nil).
+		SeeStatus(http.StatusOK).
+		ResponseHeader("ETag")
+	require.NotEmpty(t, etag)
+
+	tester.
+		WithHeader("If-None-Match", etag).
+		Get("/api/feed.xml", nil).
+		SeeStatus(http.StatusNotModified)
+
+	// the summary feed is a different representation
+	tester.
+		Get("/api/feed.xml", map[string]string{"content": "summary"}).
+		SeeStatus(http.StatusOK)
+}
+
+func TestFeedHandler_InvalidContent(t *testing.T) {
+	tester, _, _, _ := setupFeedTest(t)
+
+	tester.
+		Get("/api/feed.json", map[string]string{"content": "everything"}).
+		SeeStatus(http.StatusBadRequest)
+}
+
+func TestFeedHandler_Category(t *testing.T) {
+	tester, mockArticleRepo, mockCategoryRepo, _ := setupFeedTest(t)
+	category := factory.NewCategoryFactory().BuildEntity()
+
+	mockCategoryRepo.On("FindAll", mock.MatchedBy(func(q *categoryQuery.CategoryQuery) bool {
+		return q.Slug == category.Slug
+	})).Return([]*categoryEntity.Category{category}, int64(1), nil)
+	mockArticleRepo.On("FindAll", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return q.CategoryID != nil && *q.CategoryID == category.ID && q.IncludeDescendants
+	})).Return(publishedArticles(), int64(0), nil)
+
+	tester.
+		Get("/api/categories/"+category.Slug+"/atom.xml", nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestFeedHandler_Category_NotFound(t *testing.T) {
+	tester, _, mockCategoryRepo, _ := setupFeedTest(t)
+
+	mockCategoryRepo.On("FindAll", mock.AnythingOfType("*query.CategoryQuery")).
+		Return([]*categoryEntity.Category{}, int64(0), nil)
+
+	tester.
+		Get("/api/categories/missing/feed.xml", nil).
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestFeedHandler_Tag(t *testing.T) {
+	tester, mockArticleRepo, _, mockTagRepo := setupFeedTest(t)
+	tag := factory.NewTagFactory().BuildEntity()
+
+	mockTagRepo.On("FindByID", tag.ID, mock.Anything).Return(tag, nil)
+	mockArticleRepo.On("FindAll", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return len(q.TagIDs) == 1 && q.TagIDs[0] == tag.ID
+	})).Return(publishedArticles(), int64(0), nil)
+
+	tester.
+		Get("/api/tags/"+strconv.Itoa(int(tag.ID))+"/feed.json", nil).
+		SeeStatus(http.StatusOK)
+}
@@ -3,12 +3,23 @@ package http_test
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 
 	articleService "github.com/jambo0624/blog/internal/article/application/service"
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	articleQuery "github.com/jambo0624/blog/internal/article/domain/query"
 	articleHandler "github.com/jambo0624/blog/internal/article/interfaces/http"
+	"github.com/jambo0624/blog/internal/article/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
 	"github.com/jambo0624/blog/tests/testutil"
 	"github.com/jambo0624/blog/tests/testutil/factory"
 	mockArticle "github.com/jambo0624/blog/tests/testutil/mock/article"
@@ -21,23 +32,39 @@ func setupTest(t *testing.T) (
 	*mockArticle.MockArticleRepository,
 	*mockCategory.MockCategoryRepository,
 	*mockTag.MockTagRepository,
+	*mockArticle.MockArticleRevisionRepository,
+) {
+	t.Helper()
+	return setupTestAs(t, 1, auth.RoleAdmin)
+}
+
+// setupTestAs authenticates every request as the given user and role.
+func setupTestAs(t *testing.T, userID uint, role auth.Role) (
+	*testutil.HTTPTester,
+	*mockArticle.MockArticleRepository,
+	*mockCategory.MockCategoryRepository,
+	*mockTag.MockTagRepository,
+	*mockArticle.MockArticleRevisionRepository,
 ) {
 	t.Helper()
 	mockArticleRepo := new(mockArticle.MockArticleRepository)
 	mockCategoryRepo := new(mockCategory.MockCategoryRepository)
 	mockTagRepo := new(mockTag.MockTagRepository)
+	mockRevisionRepo := new(mockArticle.MockArticleRevisionRepository)
 
-	service := articleService.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo)
+	service := articleService.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo, mockRevisionRepo)
 	handler := articleHandler.NewArticleHandler(service)
 	router := articleHandler.NewArticleRouter(handler)
 
-	tester := testutil.NewHTTPTester(t, router.Register)
+	authenticator := testutil.NewFakeAuthenticator(userID, role)
+	tester := testutil.NewHTTPTester(t, testutil.WithAuth(authenticator, router.Register)).
+		WithHeader("Authorization", "Bearer "+testutil.TestToken)
 
-	return tester, mockArticleRepo, mockCategoryRepo, mockTagRepo
+	return tester, mockArticleRepo, mockCategoryRepo, mockTagRepo, mockRevisionRepo
 }
 
 func TestArticleHandler_Create(t *testing.T) {
-	tester, mockArticleRepo, mockCategoryRepo, mockTagRepo := setupTest(t)
+	tester, mockArticleRepo, mockCategoryRepo, mockTagRepo, mockRevisionRepo := setupTest(t)
 
 	categoryFactory := factory.NewCategoryFactory()
 	tagFactory := factory.NewTagFactory()
@@ -47,7 +74,10 @@ func TestArticleHandler_Create(t *testing.T) {
 
 	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
 	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+	mockArticleRepo.On("SlugTaken", mock.AnythingOfType("string"), uint(0)).Return(false, nil)
 	mockArticleRepo.On("Save", mock.AnythingOfType("*entity.Article")).Return(nil)
+	mockRevisionRepo.On("LatestNumber", mock.AnythingOfType("uint")).Return(uint(0), nil)
+	mockRevisionRepo.On("Save", mock.AnythingOfType("*entity.ArticleRevision")).Return(nil)
 
 	tester.
 		WithJSONBody(req).
@@ -55,8 +85,42 @@ func TestArticleHandler_Create(t *testing.T) {
 		SeeStatus(http.StatusCreated)
 }
 
+func TestArticleHandler_Create_RecordsAuthor(t *testing.T) {
+	tester, mockArticleRepo, mockCategoryRepo, mockTagRepo, mockRevisionRepo := setupTestAs(t, 7, auth.RoleAuthor)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+
+	req, category, tag := articleFactory.BuildCreateRequest()
+
+	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
+	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+	mockArticleRepo.On("SlugTaken", mock.AnythingOfType("string"), uint(0)).Return(false, nil)
+	mockArticleRepo.On("Save", mock.MatchedBy(func(a *articleEntity.Article) bool {
+		return a.AuthorID != nil && *a.AuthorID == 7
+	})).Return(nil)
+	mockRevisionRepo.On("LatestNumber", mock.AnythingOfType("uint")).Return(uint(0), nil)
+	mockRevisionRepo.On("Save", mock.AnythingOfType("*entity.ArticleRevision")).Return(nil)
+
+	tester.
+		WithJSONBody(req).
+		Post("/api/articles").
+		SeeStatus(http.StatusCreated)
+}
+
+func TestArticleHandler_Create_Reader(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleReader)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	req, _, _ := articleFactory.BuildCreateRequest()
+
+	tester.
+		WithJSONBody(req).
+		Post("/api/articles").
+		SeeStatus(http.StatusForbidden)
+
+	mockArticleRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
 func TestArticleHandler_GetByID(t *testing.T) {
-	tester, mockArticleRepo, _, _ := setupTest(t)
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
 	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
 	article, _, _ := articleFactory.BuildEntity()
 
@@ -67,21 +131,390 @@ func TestArticleHandler_GetByID(t *testing.T) {
 		SeeStatus(http.StatusOK)
 }
 
+func TestArticleHandler_GetByID_RendersContent(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithContent("Some *emphasis*"))
+
+	mockArticleRepo.On("FindByID", article.ID, mock.Anything).Return(article, nil)
+
+	body := tester.
+		Get(fmt.Sprintf("/api/articles/%d", article.ID), nil).
+		SeeStatus(http.StatusOK).
+		Body()
+
+	assert.Contains(t, body, `"rendered":{"html":"\u003cp\u003eSome \u003cem\u003eemphasis`)
+}
+
+func TestArticleHandler_GetByID_NotFound(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+
+	mockArticleRepo.On("FindByID", uint(999), mock.Anything).Return(nil, domainErrors.ErrNotFound)
+
+	tester.
+		Get("/api/articles/999", nil).
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestArticleHandler_GetByID_DatabaseError(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+
+	mockArticleRepo.On("FindByID", uint(1), mock.Anything).Return(nil, gorm.ErrInvalidDB)
+
+	tester.
+		Get("/api/articles/1", nil).
+		SeeStatus(http.StatusInternalServerError)
+}
+
+func TestArticleHandler_GetByID_DraftHidden(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleReader)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithStatus(articleEntity.StatusDraft))
+
+	mockArticleRepo.On("FindByID", article.ID, mock.Anything).Return(article, nil)
+
+	tester.
+		Get(fmt.Sprintf("/api/articles/%d", article.ID), nil).
+		SeeStatus(http.StatusNotFound)
+
+	tester.
+		WithHeader("Authorization", "").
+		Get(fmt.Sprintf("/api/articles/%d", article.ID), nil).
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestArticleHandler_GetByID_AuthorReadsOwnDraft(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleAuthor)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(
+		articleFactory.WithStatus(articleEntity.StatusDraft),
+		articleFactory.WithAuthorID(7),
+	)
+
+	mockArticleRepo.On("FindByID", article.ID, mock.Anything).Return(article, nil)
+
+	tester.
+		Get(fmt.Sprintf("/api/articles/%d", article.ID), nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_GetBySlug(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity()
+
+	mockArticleRepo.On("FindBySlug", article.Slug, mock.Anything).Return(article, nil)
+
+	tester.
+		Get("/api/articles/slug/"+article.Slug, nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_GetBySlug_ScheduledHidden(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleAuthor)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(
+		articleFactory.WithStatus(articleEntity.StatusScheduled),
+		articleFactory.WithAuthorID(8),
+	)
+
+	mockArticleRepo.On("FindBySlug", article.Slug, mock.Anything).Return(article, nil)
+
+	tester.
+		Get("/api/articles/slug/"+article.Slug, nil).
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestArticleHandler_GetBySlug_RedirectsPreviousSlug(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity()
+
+	mockArticleRepo.On("FindBySlug", "old-slug", mock.Anything).Return(nil, domainErrors.ErrNotFound)
+	mockArticleRepo.On("FindByPreviousSlug", "old-slug").Return(article, nil)
+
+	tester.
+		Get("/api/articles/slug/old-slug", nil).
+		SeeStatus(http.StatusMovedPermanently).
+		SeeHeader("Location", "/api/articles/slug/"+article.Slug)
+}
+
+func TestArticleHandler_GetBySlug_NotFound(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+
+	mockArticleRepo.On("FindBySlug", "missing", mock.Anything).Return(nil, domainErrors.ErrNotFound)
+	mockArticleRepo.On("FindByPreviousSlug", "missing").Return(nil, domainErrors.ErrNotFound)
+
+	tester.
+		Get("/api/articles/slug/missing", nil).
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestArticleHandler_Create_InvalidSlug(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	req, _, _ := articleFactory.BuildCreateRequest(func(r *dto.CreateArticleRequest) {
+		r.Slug = "Not A Slug"
+	})
+
+	body := tester.
+		WithJSONBody(req).
+		Post("/api/articles").
+		SeeStatus(http.StatusUnprocessableEntity).
+		Body()
+
+	assert.Contains(t, body, `{"field":"slug","rule":"slug"`)
+	mockArticleRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestArticleHandler_Create_ReportsAllFields(t *testing.T) {
+	tester, _, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	req, _, _ := articleFactory.BuildCreateRequest(func(r *dto.CreateArticleRequest) {
+		r.Title = strings.Repeat("a", constants.MaxTitleLength+1)
+		r.Content = ""
+	})
+
+	body := tester.
+		WithJSONBody(req).
+		Post("/api/articles").
+		SeeStatus(http.StatusUnprocessableEntity).
+		Body()
+
+	assert.Contains(t, body, `{"field":"content","rule":"required","message":"is required"}`)
+	assert.Contains(t, body, `{"field":"title","rule":"max","message":"must be at most 255 characters"}`)
+}
+
+func TestArticleHandler_Create_WrongType(t *testing.T) {
+	tester, _, _, _, _ := setupTest(t)
+
+	body := tester.
+		WithJSONBody(map[string]any{"title": "Title", "content": "Content", "categoryId": "one"}).
+		Post("/api/articles").
+		SeeStatus(http.StatusUnprocessableEntity).
+		Body()
+
+	assert.Contains(t, body, `{"field":"categoryId","rule":"type","message":"must be a number"}`)
+}
+
+func TestArticleHandler_Create_UnknownCategory(t *testing.T) {
+	tester, mockArticleRepo, mockCategoryRepo, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	req, _, _ := articleFactory.BuildCreateRequest()
+
+	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(nil, domainErrors.ErrNotFound)
+
+	tester.
+		WithJSONBody(req).
+		Post("/api/articles").
+		SeeStatus(http.StatusBadRequest)
+
+	mockArticleRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestArticleHandler_Create_Duplicate(t *testing.T) {
+	tester, mockArticleRepo, mockCategoryRepo, mockTagRepo, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	req, category, tag := articleFactory.BuildCreateRequest()
+
+	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
+	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+	mockArticleRepo.On("SlugTaken", mock.AnythingOfType("string"), uint(0)).Return(false, nil)
+	mockArticleRepo.On("Save", mock.AnythingOfType("*entity.Article")).
+		Return(fmt.Errorf("%w: duplicate key", domainErrors.ErrDuplicate))
+
+	body := tester.
+		WithJSONBody(req).
+		Post("/api/articles").
+		SeeStatus(http.StatusConflict).
+		Body()
+
+	assert.Contains(t, body, fmt.Sprintf(`"code":%d`, response.CodeConflict))
+}
+
 func TestArticleHandler_List(t *testing.T) {
-	tester, mockArticleRepo, _, _ := setupTest(t)
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	articles := articleFactory.BuildList(2)
+
+	mockArticleRepo.On("FindAll", mock.AnythingOfType("*query.ArticleQuery")).
+		Return(articles, int64(len(articles)), nil)
+
+	tester.
+		Get("/api/articles", nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_List_NotModified(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
 	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
 	articles := articleFactory.BuildList(2)
+	for _, article := range articles {
+		article.UpdatedAt = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	}
 
 	mockArticleRepo.On("FindAll", mock.AnythingOfType("*query.ArticleQuery")).
 		Return(articles, int64(len(articles)), nil)
 
+	etag := tester.
+		Get("/api/articles", nil).
+		SeeStatus(http.StatusOK).
+		ResponseHeader("ETag")
+
+	tester.
+		WithHeader("If-None-Match", etag).
+		Get("/api/articles", nil).
+		SeeStatus(http.StatusNotModified)
+
+	// derived data changes without moving the version or UpdatedAt
+	articles[0].CommentCount++
+	tester.
+		Get("/api/articles", nil).
+		SeeStatus(http.StatusOK)
+
+	etag = tester.ResponseHeader("ETag")
+	articles[1].Category.Name = "Renamed"
+	tester.
+		WithHeader("If-None-Match", etag).
+		Get("/api/articles", nil).
+		SeeStatus(http.StatusOK)
+
+	// the latest UpdatedAt alone does not validate a list
+	tester.
+		WithHeader("If-None-Match", "").
+		WithHeader("If-Modified-Since", "Wed, 01 May 2024 12:00:00 GMT").
+		Get("/api/articles", nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_GetByID_IgnoresIfModifiedSince(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithStatus(articleEntity.StatusPublished))
+	article.UpdatedAt = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mockArticleRepo.On("FindByID", article.ID, mock.Anything).Return(article, nil)
+
+	tester.
+		WithHeader("If-Modified-Since", "Wed, 01 May 2024 12:00:00 GMT").
+		Get(fmt.Sprintf("/api/articles/%d", article.ID), nil).
+		SeeStatus(http.StatusOK).
+		SeeHeader("Last-Modified", "Wed, 01 May 2024 12:00:00 GMT")
+}
+
+func TestArticleHandler_List_PublishedByDefault(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	articles := articleFactory.BuildList(1)
+
+	mockArticleRepo.On("FindAll", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return len(q.Statuses) == 1 && q.Statuses[0] == articleQuery.StatusPublished
+	})).Return(articles, int64(len(articles)), nil)
+
 	tester.
 		Get("/api/articles", nil).
 		SeeStatus(http.StatusOK)
 }
 
+func TestArticleHandler_List_UnpublishedAnonymous(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+
+	tester.
+		WithHeader("Authorization", "").
+		Get("/api/articles", map[string]string{"status": "all"}).
+		SeeStatus(http.StatusUnauthorized)
+
+	tester.
+		Get("/api/articles", map[string]string{"status": "draft,scheduled"}).
+		SeeStatus(http.StatusUnauthorized)
+
+	mockArticleRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+}
+
+func TestArticleHandler_List_UnpublishedEditor(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleEditor)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	articles := articleFactory.BuildList(1)
+
+	mockArticleRepo.On("FindAll", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return len(q.Statuses) == 0
+	})).Return(articles, int64(len(articles)), nil)
+
+	tester.
+		Get("/api/articles", map[string]string{"status": "all"}).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_List_UnpublishedAuthor(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleAuthor)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	articles := articleFactory.BuildList(1)
+
+	mockArticleRepo.On("FindAll", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return q.AuthorID != nil && *q.AuthorID == 7
+	})).Return(articles, int64(len(articles)), nil)
+
+	// their own drafts only
+	tester.
+		Get("/api/authors/7/articles", map[string]string{"status": "draft"}).
+		SeeStatus(http.StatusOK)
+
+	tester.
+		Get("/api/articles", map[string]string{"status": "draft"}).
+		SeeStatus(http.StatusForbidden)
+
+	tester.
+		Get("/api/articles", map[string]string{"status": "draft", "author_id": "8"}).
+		SeeStatus(http.StatusForbidden)
+}
+
+func TestArticleHandler_List_ByAuthorID(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	articles := articleFactory.BuildList(1)
+
+	mockArticleRepo.On("FindAll", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return q.AuthorID != nil && *q.AuthorID == 7
+	})).Return(articles, int64(len(articles)), nil)
+
+	tester.
+		Get("/api/articles", map[string]string{"author_id": "7"}).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_List_InvalidAuthorID(t *testing.T) {
+	tester, _, _, _, _ := setupTest(t)
+
+	tester.
+		Get("/api/articles", map[string]string{"author_id": "abc"}).
+		SeeStatus(http.StatusBadRequest)
+}
+
+func TestArticleHandler_FindByAuthor(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	articles := articleFactory.BuildList(2)
+
+	mockArticleRepo.On("FindAll", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return q.AuthorID != nil && *q.AuthorID == 7 &&
+			len(q.Statuses) == 1 && q.Statuses[0] == articleQuery.StatusPublished
+	})).Return(articles, int64(len(articles)), nil)
+
+	tester.
+		Get("/api/authors/7/articles", nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_List_InvalidStatus(t *testing.T) {
+	tester, _, _, _, _ := setupTest(t)
+
+	tester.
+		Get("/api/articles", map[string]string{"status": "unknown"}).
+		SeeStatus(http.StatusBadRequest)
+}
+
 func TestArticleHandler_Update(t *testing.T) {
-	tester, mockArticleRepo, mockCategoryRepo, mockTagRepo := setupTest(t)
+	tester, mockArticleRepo, mockCategoryRepo, mockTagRepo, mockRevisionRepo := setupTest(t)
 
 	categoryFactory := factory.NewCategoryFactory()
 	tagFactory := factory.NewTagFactory()
@@ -95,19 +528,300 @@ func TestArticleHandler_Update(t *testing.T) {
 	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
 	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
 	mockArticleRepo.On("Update", mock.AnythingOfType("*entity.Article")).Return(nil)
+	mockRevisionRepo.On("LatestNumber", article.ID).Return(uint(1), nil)
+	mockRevisionRepo.On("Save", mock.AnythingOfType("*entity.ArticleRevision")).Return(nil)
 
 	tester.
+		WithHeader("If-Match", fmt.Sprintf(`"%d"`, article.Version)).
 		WithJSONBody(req).
 		Put(fmt.Sprintf("/api/articles/%d", article.ID)).
 		SeeStatus(http.StatusOK)
 }
 
+func TestArticleHandler_Update_AuthorOwnsArticle(t *testing.T) {
+	tester, mockArticleRepo, mockCategoryRepo, mockTagRepo, mockRevisionRepo := setupTestAs(t, 7, auth.RoleAuthor)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithAuthorID(7))
+
+	req, category, tag := articleFactory.BuildUpdateRequest()
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	mockCategoryRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(category, nil)
+	mockTagRepo.On("FindByID", mock.AnythingOfType("uint"), []string(nil)).Return(tag, nil)
+	mockArticleRepo.On("Update", mock.AnythingOfType("*entity.Article")).Return(nil)
+	mockRevisionRepo.On("LatestNumber", article.ID).Return(uint(1), nil)
+	mockRevisionRepo.On("Save", mock.AnythingOfType("*entity.ArticleRevision")).Return(nil)
+
+	tester.
+		WithHeader("If-Match", fmt.Sprintf(`"%d"`, article.Version)).
+		WithJSONBody(req).
+		Put(fmt.Sprintf("/api/articles/%d", article.ID)).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_Update_AuthorNotOwner(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleAuthor)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithAuthorID(8))
+	req, _, _ := articleFactory.BuildUpdateRequest()
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+	tester.
+		WithJSONBody(req).
+		Put(fmt.Sprintf("/api/articles/%d", article.ID)).
+		SeeStatus(http.StatusForbidden)
+
+	mockArticleRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
 func TestArticleHandler_Delete(t *testing.T) {
-	tester, mockArticleRepo, _, _ := setupTest(t)
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity()
 
-	mockArticleRepo.On("Delete", uint(1)).Return(nil)
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	mockArticleRepo.On("Delete", article.ID).Return(nil)
 
 	tester.
-		Delete("/api/articles/1").
+		Delete(fmt.Sprintf("/api/articles/%d", article.ID)).
 		SeeStatus(http.StatusNoContent)
 }
+
+func TestArticleHandler_Publish(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity()
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	mockArticleRepo.On("Update", mock.MatchedBy(func(a *articleEntity.Article) bool {
+		return a.Status == articleEntity.StatusPublished
+	})).Return(nil)
+
+	tester.
+		Post(fmt.Sprintf("/api/articles/%d/publish", article.ID)).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_Publish_Author(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleAuthor)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithAuthorID(7))
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+	tester.
+		Post(fmt.Sprintf("/api/articles/%d/publish", article.ID)).
+		SeeStatus(http.StatusForbidden)
+
+	mockArticleRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestArticleHandler_Publish_Unauthenticated(t *testing.T) {
+	tester, _, _, _, _ := setupTest(t)
+
+	tester.
+		WithHeader("Authorization", "").
+		Post("/api/articles/1/publish").
+		SeeStatus(http.StatusUnauthorized)
+}
+
+func TestArticleHandler_Unpublish_InvalidTransition(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity()
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+	tester.
+		Post(fmt.Sprintf("/api/articles/%d/unpublish", article.ID)).
+		SeeStatus(http.StatusConflict)
+}
+
+func TestArticleHandler_Schedule(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity()
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	mockArticleRepo.On("Update", mock.MatchedBy(func(a *articleEntity.Article) bool {
+		return a.Status == articleEntity.StatusScheduled && a.ScheduledFor != nil
+	})).Return(nil)
+
+	tester.
+		WithJSONBody(dto.ScheduleArticleRequest{ScheduledFor: time.Now().Add(time.Hour)}).
+		Post(fmt.Sprintf("/api/articles/%d/schedule", article.ID)).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_FindRevisions(t *testing.T) {
+	tester, mockArticleRepo, _, _, mockRevisionRepo := setupTest(t)
+	article, _, _ := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory()).BuildEntity()
+	article.ID = 1
+
+	mockArticleRepo.On("FindByID", uint(1), []string(nil)).Return(article, nil)
+
+	revisions := []*articleEntity.ArticleRevision{
+		{ArticleID: 1, Number: 2, Title: "Title", Content: "Updated"},
+		{ArticleID: 1, Number: 1, Title: "Title", Content: "Original"},
+	}
+	mockRevisionRepo.On("FindByArticleID", uint(1)).Return(revisions, nil)
+
+	tester.
+		Get("/api/articles/1/revisions", nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_DiffRevisions(t *testing.T) {
+	tester, mockArticleRepo, _, _, mockRevisionRepo := setupTest(t)
+	article, _, _ := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory()).BuildEntity()
+	article.ID = 1
+
+	mockArticleRepo.On("FindByID", uint(1), []string(nil)).Return(article, nil)
+
+	mockRevisionRepo.On("FindByNumber", uint(1), uint(1)).
+		Return(&articleEntity.ArticleRevision{ArticleID: 1, Number: 1, Title: "Title", Content: "a\nb"}, nil)
+	mockRevisionRepo.On("FindByNumber", uint(1), uint(2)).
+		Return(&articleEntity.ArticleRevision{ArticleID: 1, Number: 2, Title: "Title", Content: "a\nc"}, nil)
+
+	body := tester.
+		Get("/api/articles/1/revisions/diff", map[string]string{"from": "1", "to": "2"}).
+		SeeStatus(http.StatusOK).
+		Body()
+
+	assert.Contains(t, body, `"data":{"articleId":1,"from":1,"to":2,`+
+		`"title":[{"op":"equal","text":"Title"}],`+
+		`"content":[{"op":"equal","text":"a"},{"op":"delete","text":"b"},{"op":"insert","text":"c"}],`+
+		`"changed":true}`)
+}
+
+func TestArticleHandler_FindRevisions_Reader(t *testing.T) {
+	tester, mockArticleRepo, _, _, mockRevisionRepo := setupTestAs(t, 7, auth.RoleReader)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithStatus(articleEntity.StatusPublished))
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+	tester.
+		Get(fmt.Sprintf("/api/articles/%d/revisions", article.ID), nil).
+		SeeStatus(http.StatusForbidden)
+	tester.
+		Get(fmt.Sprintf("/api/articles/%d/revisions/1", article.ID), nil).
+		SeeStatus(http.StatusForbidden)
+	tester.
+		WithHeader("Authorization", "").
+		Get(fmt.Sprintf("/api/articles/%d/revisions/diff", article.ID), map[string]string{"from": "1", "to": "2"}).
+		SeeStatus(http.StatusUnauthorized)
+
+	mockRevisionRepo.AssertNotCalled(t, "FindByArticleID", mock.Anything)
+	mockRevisionRepo.AssertNotCalled(t, "FindByNumber", mock.Anything, mock.Anything)
+}
+
+func TestArticleHandler_DiffRevisions_MissingParams(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	article, _, _ := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory()).BuildEntity()
+
+	mockArticleRepo.On("FindByID", uint(1), []string(nil)).Return(article, nil)
+
+	tester.
+		Get("/api/articles/1/revisions/diff", map[string]string{"from": "1"}).
+		SeeStatus(http.StatusBadRequest)
+}
+
+func TestArticleHandler_RestoreRevision(t *testing.T) {
+	tester, mockArticleRepo, _, _, mockRevisionRepo := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity()
+	revision := &articleEntity.ArticleRevision{ArticleID: article.ID, Number: 1, Title: "Old Title", Content: "Old Content"}
+
+	mockArticleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	mockRevisionRepo.On("FindByNumber", article.ID, uint(1)).Return(revision, nil)
+	mockArticleRepo.On("Update", mock.MatchedBy(func(a *articleEntity.Article) bool {
+		return a.Title == revision.Title && a.Content == revision.Content
+	})).Return(nil)
+	mockRevisionRepo.On("LatestNumber", article.ID).Return(uint(3), nil)
+	mockRevisionRepo.On("Save", mock.MatchedBy(func(r *articleEntity.ArticleRevision) bool {
+		return r.Number == 4 && r.Content == revision.Content
+	})).Return(nil)
+
+	tester.
+		Post(fmt.Sprintf("/api/articles/%d/revisions/1/restore", article.ID)).
+		SeeStatus(http.StatusOK)
+	mockRevisionRepo.AssertExpectations(t)
+}
+
+func TestArticleHandler_Search(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity()
+	results := []*articleEntity.ArticleSearchResult{{Article: article, Rank: 0.5}}
+
+	mockArticleRepo.On("Search", mock.MatchedBy(func(q *articleQuery.ArticleQuery) bool {
+		return q.Search == "domain:* & driven" && len(q.Statuses) == 1
+	})).Return(results, int64(len(results)), nil)
+
+	tester.
+		Get("/api/articles/search", map[string]string{"q": "domain* driven"}).
+		SeeStatus(http.StatusOK)
+	mockArticleRepo.AssertExpectations(t)
+}
+
+func TestArticleHandler_Search_UnpublishedReader(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleReader)
+
+	tester.
+		Get("/api/articles/search", map[string]string{"q": "draft", "status": "all"}).
+		SeeStatus(http.StatusForbidden)
+
+	mockArticleRepo.AssertNotCalled(t, "Search", mock.Anything)
+}
+
+func TestArticleHandler_Search_MissingQuery(t *testing.T) {
+	tester, _, _, _, _ := setupTest(t)
+
+	tester.
+		Get("/api/articles/search", nil).
+		SeeStatus(http.StatusBadRequest)
+}
+
+func TestArticleHandler_Restore_Editor(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleEditor)
+	article, _, _ := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory()).BuildEntity()
+
+	mockArticleRepo.On("Restore", article.ID).Return(nil)
+	mockArticleRepo.On("FindByID", article.ID, mock.Anything).Return(article, nil)
+
+	tester.
+		Post(fmt.Sprintf("/api/articles/%d/restore", article.ID)).
+		SeeStatus(http.StatusOK)
+}
+
+func TestArticleHandler_Restore_Author(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleAuthor)
+
+	tester.
+		Post("/api/articles/1/restore").
+		SeeStatus(http.StatusForbidden)
+
+	mockArticleRepo.AssertNotCalled(t, "Restore", mock.Anything)
+}
+
+func TestArticleHandler_Purge(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTest(t)
+
+	mockArticleRepo.On("Purge", uint(1)).Return(nil)
+
+	tester.
+		Delete("/api/articles/1/purge").
+		SeeStatus(http.StatusNoContent)
+}
+
+func TestArticleHandler_Purge_Editor(t *testing.T) {
+	tester, mockArticleRepo, _, _, _ := setupTestAs(t, 7, auth.RoleEditor)
+
+	tester.
+		Delete("/api/articles/1/purge").
+		SeeStatus(http.StatusForbidden)
+
+	mockArticleRepo.AssertNotCalled(t, "Purge", mock.Anything)
+}
@@ -0,0 +1,94 @@
+package bootstrap_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/bootstrap"
+	"github.com/jambo0624/blog/tests/testutil"
+)
+
+func setupHealthTest(t *testing.T, health *bootstrap.Health) *testutil.HTTPTester {
+	t.Helper()
+
+	return testutil.NewHTTPTester(t, func(api *gin.RouterGroup) {
+		health.Register(api)
+	})
+}
+
+func decodeReport(t *testing.T, body string) bootstrap.HealthReport {
+	t.Helper()
+
+	var report bootstrap.HealthReport
+	require.NoError(t, json.Unmarshal([]byte(body), &report))
+	return report
+}
+
+func TestHealth_Ready(t *testing.T) {
+	health := bootstrap.NewHealth()
+	health.AddCheck("database", func(context.Context) error { return nil })
+	tester := setupHealthTest(t, health)
+
+	body := tester.
+		Get("/api/readyz", nil).
+		SeeStatus(http.StatusOK).
+		SeeHeader("Cache-Control", "no-store").
+		Body()
+
+	report := decodeReport(t, body)
+	assert.Equal(t, bootstrap.HealthOK, report.Status)
+	require.Len(t, report.Checks, 1)
+	assert.Equal(t, "database", report.Checks[0].Name)
+	assert.Equal(t, bootstrap.HealthOK, report.Checks[0].Status)
+}
+
+func TestHealth_NotReadyWhenACheckFails(t *testing.T) {
+	health := bootstrap.NewHealth()
+	health.AddCheck("database", func(context.Context) error { return nil })
+	health.AddCheck("migrations", func(context.Context) error { return errors.New("migration 013 pending") })
+	tester := setupHealthTest(t, health)
+
+	body := tester.
+		Get("/api/readyz", nil).
+		SeeStatus(http.StatusServiceUnavailable).
+		Body()
+
+	report := decodeReport(t, body)
+	assert.Equal(t, bootstrap.HealthFailing, report.Status)
+	assert.Equal(t, []string{bootstrap.HealthOK, bootstrap.HealthFailing},
+		[]string{report.Checks[0].Status, report.Checks[1].Status})
+	assert.Equal(t, "migration 013 pending", report.Checks[1].Error)
+
+	// liveness does not depend on the checks
+	tester.
+		Get("/api/healthz", nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestHealth_ShuttingDown(t *testing.T) {
+	health := bootstrap.NewHealth()
+	health.AddCheck("database", func(context.Context) error {
+		t.Error("checks should not run while shutting down")
+		return nil
+	})
+	tester := setupHealthTest(t, health)
+
+	health.MarkShuttingDown()
+
+	body := tester.
+		Get("/api/readyz", nil).
+		SeeStatus(http.StatusServiceUnavailable).
+		Body()
+	assert.Equal(t, bootstrap.HealthShuttingDown, decodeReport(t, body).Status)
+
+	tester.
+		Get("/api/healthz", nil).
+		SeeStatus(http.StatusOK)
+}
@@ -0,0 +1,140 @@
+package bootstrap_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/bootstrap"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/config"
+)
+
+func TestServer_DrainsRequestsBeforeHooks(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := bootstrap.NewServer(config.ServerConfig{ShutdownTimeout: 5 * time.Second}, handler)
+	var mu sync.Mutex
+	var steps []string
+	record := func(name string) bootstrap.ShutdownHook {
+		return func(context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			steps = append(steps, name)
+			return nil
+		}
+	}
+	server.OnShutdown("workers", record("workers"))
+	server.OnShutdown("database", record("database"))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(ctx, listener)
+	}()
+
+	status := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + listener.Addr().String())
+		if err != nil {
+			status <- 0
+			return
+		}
+		resp.Body.Close()
+		status <- resp.StatusCode
+	}()
+
+	<-started
+	cancel()
+
+	// the in-flight request holds up the shutdown hooks
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	assert.Empty(t, steps)
+	mu.Unlock()
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-status)
+	require.NoError(t, <-served)
+	assert.Equal(t, []string{"workers", "database"}, steps)
+}
+
+func TestServer_JoinsHookErrors(t *testing.T) {
+	errClose := errors.New("close failed")
+	server := bootstrap.NewServer(config.ServerConfig{ShutdownTimeout: time.Second}, http.NotFoundHandler())
+
+	var ran bool
+	server.OnShutdown("database", func(context.Context) error { return errClose })
+	server.OnShutdown("cache", func(context.Context) error {
+		ran = true
+		return nil
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = server.Serve(ctx, listener)
+	require.ErrorIs(t, err, errClose)
+	assert.True(t, ran, "later hooks run after a failed one")
+}
+
+func TestServer_NotReadyOnShutdown(t *testing.T) {
+	health := bootstrap.NewHealth()
+	server := bootstrap.NewServer(config.ServerConfig{ShutdownTimeout: time.Second}, http.NotFoundHandler()).
+		WithHealth(health)
+
+	var report bootstrap.HealthReport
+	server.OnShutdown("health", func(ctx context.Context) error {
+		report = health.Ready(ctx)
+		return nil
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.NoError(t, server.Serve(ctx, listener))
+	assert.Equal(t, bootstrap.HealthShuttingDown, report.Status)
+}
+
+type blockingWorker struct {
+	stopped chan struct{}
+}
+
+func (w *blockingWorker) Start(ctx context.Context) {
+	<-ctx.Done()
+	close(w.stopped)
+}
+
+func TestWorkerGroup_Stop(t *testing.T) {
+	worker := &blockingWorker{stopped: make(chan struct{})}
+	group := bootstrap.StartWorkers(context.Background(), []bootstrap.Worker{worker})
+
+	require.NoError(t, group.Stop(context.Background()))
+
+	select {
+	case <-worker.stopped:
+	default:
+		t.Fatal("Stop returned before the worker did")
+	}
+}
@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,7 +10,9 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/jambo0624/blog/internal/category/application/service"
+	"github.com/jambo0624/blog/internal/category/domain/entity"
 	"github.com/jambo0624/blog/internal/category/domain/query"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
 	factory "github.com/jambo0624/blog/tests/testutil/factory"
 	mockCategory "github.com/jambo0624/blog/tests/testutil/mock/category"
 )
@@ -35,7 +38,7 @@ func TestCategoryService_Create(t *testing.T) {
 
 	mockRepo.On("Save", mock.AnythingOfType("*entity.Category")).Return(nil)
 
-	category, err := categoryService.Create(req)
+	category, err := categoryService.Create(context.Background(), req)
 
 	require.NoError(t, err)
 	require.NotNil(t, category)
@@ -51,7 +54,7 @@ func TestCategoryService_FindAll(t *testing.T) {
 	q := query.NewCategoryQuery()
 	mockRepo.On("FindAll", q).Return(expectedCategories, int64(2), nil)
 
-	found, total, err := categoryService.FindAll(q)
+	found, total, err := categoryService.FindAll(context.Background(), q)
 
 	require.NoError(t, err)
 	assert.Equal(t, int64(len(expectedCategories)), total)
@@ -68,7 +71,7 @@ func TestCategoryService_Update(t *testing.T) {
 
 	req := factory.BuildUpdateRequest()
 
-	updated, err := categoryService.Update(1, req)
+	updated, err := categoryService.Update(context.Background(), 1, req)
 
 	require.NoError(t, err)
 	assert.Equal(t, expectedCategory.Name, updated.Name)
@@ -80,7 +83,7 @@ func TestCategoryService_Delete(t *testing.T) {
 
 	mockRepo.On("Delete", uint(1)).Return(nil)
 
-	err := categoryService.Delete(1)
+	err := categoryService.Delete(context.Background(), 1)
 
 	require.NoError(t, err)
 	mockRepo.AssertExpectations(t)
@@ -92,7 +95,7 @@ func TestCategoryService_Create_ValidationError(t *testing.T) {
 	req := factory.BuildCreateRequest()
 	req.Name = "" // invalid name
 
-	category, err := categoryService.Create(req)
+	category, err := categoryService.Create(context.Background(), req)
 	require.Error(t, err)
 	assert.Nil(t, category)
 	mockRepo.AssertNotCalled(t, "Save")
@@ -104,9 +107,83 @@ func TestCategoryService_Update_NotFound(t *testing.T) {
 	mockRepo.On("FindByID", uint(999), mock.Anything).Return(nil, gorm.ErrRecordNotFound)
 
 	req := factory.BuildUpdateRequest()
-	category, err := categoryService.Update(999, req)
+	category, err := categoryService.Update(context.Background(), 999, req)
 
 	require.Error(t, err)
 	assert.Nil(t, category)
 	mockRepo.AssertNotCalled(t, "Update")
 }
+
+func TestCategoryService_Tree(t *testing.T) {
+	mockRepo, categoryService, factory := setupTest(t)
+
+	root := factory.BuildEntity()
+	child := factory.BuildEntity(factory.WithParentID(root.ID))
+
+	mockRepo.On("FindAll", mock.AnythingOfType("*query.CategoryQuery")).
+		Return([]*entity.Category{root, child}, int64(2), nil)
+
+	roots, err := categoryService.Tree(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+	assert.Equal(t, root.ID, roots[0].ID)
+	require.Len(t, roots[0].Children, 1)
+	assert.Equal(t, child.ID, roots[0].Children[0].ID)
+}
+
+func TestCategoryService_Breadcrumb(t *testing.T) {
+	mockRepo, categoryService, factory := setupTest(t)
+
+	root := factory.BuildEntity()
+	child := factory.BuildEntity(factory.WithParentID(root.ID))
+
+	mockRepo.On("FindAll", mock.AnythingOfType("*query.CategoryQuery")).
+		Return([]*entity.Category{root, child}, int64(2), nil)
+
+	path, err := categoryService.Breadcrumb(context.Background(), child.ID)
+
+	require.NoError(t, err)
+	require.Len(t, path, 2)
+	assert.Equal(t, root.ID, path[0].ID)
+	assert.Equal(t, child.ID, path[1].ID)
+}
+
+func TestCategoryService_Update_MoveUnderDescendant(t *testing.T) {
+	mockRepo, categoryService, factory := setupTest(t)
+
+	root := factory.BuildEntity()
+	child := factory.BuildEntity(factory.WithParentID(root.ID))
+
+	mockRepo.On("FindByID", root.ID, mock.Anything).Return(root, nil)
+	mockRepo.On("FindByID", child.ID, mock.Anything).Return(child, nil)
+	mockRepo.On("FindAll", mock.AnythingOfType("*query.CategoryQuery")).
+		Return([]*entity.Category{root, child}, int64(2), nil)
+
+	req := factory.BuildUpdateRequest()
+	req.ParentID = &child.ID
+
+	updated, err := categoryService.Update(context.Background(), root.ID, req)
+
+	require.ErrorIs(t, err, errors.ErrCategoryCycle)
+	assert.Nil(t, updated)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCategoryService_Update_MoveToRoot(t *testing.T) {
+	mockRepo, categoryService, factory := setupTest(t)
+
+	category := factory.BuildEntity(factory.WithParentID(1))
+
+	mockRepo.On("FindByID", category.ID, mock.Anything).Return(category, nil)
+	mockRepo.On("Update", mock.AnythingOfType("*entity.Category")).Return(nil)
+
+	req := factory.BuildUpdateRequest()
+	root := uint(0)
+	req.ParentID = &root
+
+	updated, err := categoryService.Update(context.Background(), category.ID, req)
+
+	require.NoError(t, err)
+	assert.True(t, updated.IsRoot())
+}
@@ -67,3 +67,55 @@ func TestCategory_Update(t *testing.T) {
 	assert.Equal(t, "Updated", category.Name)
 	assert.Equal(t, "updated", category.Slug)
 }
+
+func TestCategory_SetParent(t *testing.T) {
+	parentID := uint(2)
+	tests := []struct {
+		name              string
+		category          *entity.Category
+		parent            *entity.Category
+		parentAncestorIDs []uint
+		expectedParentID  *uint
+		expectedErr       error
+	}{
+		{
+			name:             "move under parent",
+			category:         &entity.Category{ID: 1},
+			parent:           &entity.Category{ID: 2},
+			expectedParentID: &parentID,
+		},
+		{
+			name:             "move to root",
+			category:         &entity.Category{ID: 1, ParentID: &parentID},
+			parent:           nil,
+			expectedParentID: nil,
+		},
+		{
+			name:        "own parent",
+			category:    &entity.Category{ID: 1},
+			parent:      &entity.Category{ID: 1},
+			expectedErr: errors.ErrCategoryCycle,
+		},
+		{
+			name:              "descendant as parent",
+			category:          &entity.Category{ID: 1},
+			parent:            &entity.Category{ID: 3},
+			parentAncestorIDs: []uint{2, 1},
+			expectedErr:       errors.ErrCategoryCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.category.SetParent(tt.parent, tt.parentAncestorIDs)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedParentID, tt.category.ParentID)
+			assert.Equal(t, tt.parent == nil, tt.category.IsRoot())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/category/domain/entity"
+	"github.com/jambo0624/blog/internal/category/domain/tree"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+func uintPtr(v uint) *uint {
+	return &v
+}
+
+func buildCategories() []*entity.Category {
+	return []*entity.Category{
+		{ID: 1, Name: "Programming", Slug: "programming"},
+		{ID: 2, Name: "Go", Slug: "go", ParentID: uintPtr(1)},
+		{ID: 3, Name: "Generics", Slug: "generics", ParentID: uintPtr(2)},
+		{ID: 4, Name: "Travel", Slug: "travel"},
+	}
+}
+
+func TestTree_Roots(t *testing.T) {
+	roots := tree.New(buildCategories()).Roots()
+
+	require.Len(t, roots, 2)
+	assert.Equal(t, uint(1), roots[0].ID)
+	assert.Equal(t, uint(4), roots[1].ID)
+	require.Len(t, roots[0].Children, 1)
+	assert.Equal(t, uint(2), roots[0].Children[0].ID)
+	require.Len(t, roots[0].Children[0].Children, 1)
+	assert.Equal(t, uint(3), roots[0].Children[0].Children[0].ID)
+	assert.Empty(t, roots[1].Children)
+}
+
+func TestTree_Roots_OrphanBecomesRoot(t *testing.T) {
+	categories := []*entity.Category{
+		{ID: 5, Name: "Orphan", Slug: "orphan", ParentID: uintPtr(99)},
+	}
+
+	roots := tree.New(categories).Roots()
+
+	require.Len(t, roots, 1)
+	assert.Equal(t, uint(5), roots[0].ID)
+}
+
+func TestTree_Breadcrumb(t *testing.T) {
+	path, err := tree.New(buildCategories()).Breadcrumb(3)
+
+	require.NoError(t, err)
+	require.Len(t, path, 3)
+	assert.Equal(t, []uint{1, 2, 3}, []uint{path[0].ID, path[1].ID, path[2].ID})
+}
+
+func TestTree_Breadcrumb_NotFound(t *testing.T) {
+	_, err := tree.New(buildCategories()).Breadcrumb(42)
+
+	require.ErrorIs(t, err, errors.ErrCategoryNotFound)
+}
+
+func TestTree_AncestorIDs(t *testing.T) {
+	tr := tree.New(buildCategories())
+
+	assert.Equal(t, []uint{2, 1}, tr.AncestorIDs(3))
+	assert.Empty(t, tr.AncestorIDs(1))
+}
@@ -1,15 +1,18 @@
 package repository_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 
 	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
 	categoryQuery "github.com/jambo0624/blog/internal/category/domain/query"
 	categoryRepository "github.com/jambo0624/blog/internal/category/domain/repository"
 	categoryPersistence "github.com/jambo0624/blog/internal/category/infrastructure/repository"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
 	"github.com/jambo0624/blog/tests/testutil"
 	factory "github.com/jambo0624/blog/tests/testutil/factory"
 )
@@ -34,7 +37,7 @@ func TestGormCategoryRepository_FindByID(t *testing.T) {
 	defer cleanup()
 	category := testDB.Data.Categories[0]
 
-	found, err := repo.FindByID(category.ID)
+	found, err := repo.FindByID(context.Background(), category.ID)
 	require.NoError(t, err)
 	assert.Equal(t, category.Name, found.Name)
 }
@@ -48,7 +51,7 @@ func TestGormCategoryRepository_FindAll(t *testing.T) {
 		name := testDB.Data.Categories[0].Name
 		q.WithNameLike(name)
 
-		categories, total, err := repo.FindAll(q)
+		categories, total, err := repo.FindAll(context.Background(), q)
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Contains(t, categories[0].Name, name)
@@ -61,11 +64,11 @@ func TestGormCategoryRepository_Save(t *testing.T) {
 
 	category := factory.BuildEntity()
 
-	err := repo.Save(category)
+	err := repo.Save(context.Background(), category)
 	require.NoError(t, err)
 	require.NotZero(t, category.ID)
 
-	found, err := repo.FindByID(category.ID)
+	found, err := repo.FindByID(context.Background(), category.ID)
 	require.NoError(t, err)
 	assert.Equal(t, category.Name, found.Name)
 }
@@ -77,10 +80,10 @@ func TestGormCategoryRepository_Update(t *testing.T) {
 	category := testDB.Data.Categories[0]
 
 	category.Name = "Updated Name"
-	err := repo.Update(category)
+	err := repo.Update(context.Background(), category)
 	require.NoError(t, err)
 
-	found, err := repo.FindByID(category.ID)
+	found, err := repo.FindByID(context.Background(), category.ID)
 	require.NoError(t, err)
 	assert.Equal(t, category.Name, found.Name)
 }
@@ -91,16 +94,16 @@ func TestGormCategoryRepository_Delete(t *testing.T) {
 
 	category := testDB.Data.Categories[0]
 
-	err := repo.Delete(category.ID)
+	err := repo.Delete(context.Background(), category.ID)
 	require.NoError(t, err)
 
 	var found categoryEntity.Category
 	err = testDB.DB.Unscoped().First(&found, category.ID).Error
 	require.NoError(t, err)
-	require.NotNil(t, found.DeletedAt)
+	require.True(t, found.DeletedAt.Valid)
 
-	_, err = repo.FindByID(category.ID)
-	require.NoError(t, err)
+	_, err = repo.FindByID(context.Background(), category.ID)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
 }
 
 func TestGormCategoryRepository_FindAll_WithFilters(t *testing.T) {
@@ -136,10 +139,48 @@ func TestGormCategoryRepository_FindAll_WithFilters(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			categories, count, err := repo.FindAll(tt.buildQuery())
+			categories, count, err := repo.FindAll(context.Background(), tt.buildQuery())
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedCount, count)
 			assert.Len(t, categories, int(tt.expectedCount))
 		})
 	}
 }
+
+func TestGormCategoryRepository_Purge(t *testing.T) {
+	testDB, cleanup, repo, factory := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	category := factory.BuildEntity(factory.WithName("Parent"), factory.WithSlug("parent"))
+	require.NoError(t, testDB.DB.Create(category).Error)
+	child := factory.BuildEntity(factory.WithName("Child"), factory.WithSlug("child"), factory.WithParentID(category.ID))
+	require.NoError(t, testDB.DB.Create(child).Error)
+
+	require.NoError(t, repo.Delete(ctx, category.ID))
+	require.NoError(t, repo.Purge(ctx, category.ID))
+
+	var count int64
+	testDB.DB.Unscoped().Model(&categoryEntity.Category{}).Where("id = ?", category.ID).Count(&count)
+	assert.Zero(t, count)
+
+	found, err := repo.FindByID(ctx, child.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.ParentID, "children move up to the purged category's parent")
+}
+
+func TestGormCategoryRepository_Purge_WithArticles(t *testing.T) {
+	testDB, cleanup, repo, _ := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	category := testDB.Data.Categories[0]
+
+	require.NoError(t, repo.Delete(ctx, category.ID))
+	err := repo.Purge(ctx, category.ID)
+	require.ErrorIs(t, err, errors.ErrCategoryInUse)
+
+	var found categoryEntity.Category
+	require.NoError(t, testDB.DB.Unscoped().First(&found, category.ID).Error)
+	assert.True(t, found.DeletedAt.Valid, "the category stays in the trash")
+}
@@ -1,6 +1,7 @@
 package http_test
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -9,6 +10,8 @@ import (
 	categoryService "github.com/jambo0624/blog/internal/category/application/service"
 	"github.com/jambo0624/blog/internal/category/domain/entity"
 	categoryHandler "github.com/jambo0624/blog/internal/category/interfaces/http"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
 	"github.com/jambo0624/blog/tests/testutil"
 	"github.com/jambo0624/blog/tests/testutil/factory"
 	mockCategory "github.com/jambo0624/blog/tests/testutil/mock/category"
@@ -16,13 +19,21 @@ import (
 
 func setupTest(t *testing.T) (*testutil.HTTPTester, *mockCategory.MockCategoryRepository) {
 	t.Helper()
+	return setupTestAs(t, auth.RoleAdmin)
+}
+
+// setupTestAs authenticates every request as a user with the given role.
+func setupTestAs(t *testing.T, role auth.Role) (*testutil.HTTPTester, *mockCategory.MockCategoryRepository) {
+	t.Helper()
 
 	mockRepo := new(mockCategory.MockCategoryRepository)
 	service := categoryService.NewCategoryService(mockRepo)
 	handler := categoryHandler.NewCategoryHandler(service)
 	router := categoryHandler.NewCategoryRouter(handler)
 
-	tester := testutil.NewHTTPTester(t, router.Register)
+	authenticator := testutil.NewFakeAuthenticator(1, role)
+	tester := testutil.NewHTTPTester(t, testutil.WithAuth(authenticator, router.Register)).
+		WithHeader("Authorization", "Bearer "+testutil.TestToken)
 
 	return tester, mockRepo
 }
@@ -85,6 +96,7 @@ func TestCategoryHandler_Update(t *testing.T) {
 	})).Return(nil)
 
 	tester.
+		WithHeader("If-Match", fmt.Sprintf(`"%d"`, existingCategory.Version)).
 		WithJSONBody(req).
 		Put("/api/categories/3").
 		SeeStatus(http.StatusOK)
@@ -99,3 +111,119 @@ func TestCategoryHandler_Delete(t *testing.T) {
 		Delete("/api/categories/1").
 		SeeStatus(http.StatusNoContent)
 }
+
+func TestCategoryHandler_Purge_InUse(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	mockRepo.On("Purge", uint(1)).Return(errors.ErrCategoryInUse)
+
+	tester.
+		Delete("/api/categories/1/purge").
+		SeeStatus(http.StatusConflict)
+}
+
+func TestCategoryHandler_Update_Cycle(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewCategoryFactory()
+
+	root := factory.BuildEntity()
+	child := factory.BuildEntity(factory.WithParentID(root.ID))
+
+	mockRepo.On("FindByID", root.ID, mock.Anything).Return(root, nil)
+	mockRepo.On("FindByID", child.ID, mock.Anything).Return(child, nil)
+	mockRepo.On("FindAll", mock.AnythingOfType("*query.CategoryQuery")).
+		Return([]*entity.Category{root, child}, int64(2), nil)
+
+	req := factory.BuildUpdateRequest()
+	req.ParentID = &child.ID
+
+	tester.
+		WithHeader("If-Match", fmt.Sprintf(`"%d"`, root.Version)).
+		WithJSONBody(req).
+		Put(fmt.Sprintf("/api/categories/%d", root.ID)).
+		SeeStatus(http.StatusBadRequest)
+}
+
+func TestCategoryHandler_Update_StaleIfMatch(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewCategoryFactory()
+
+	existingCategory := factory.BuildEntity()
+	existingCategory.Version = 5
+	req := factory.BuildUpdateRequest()
+
+	mockRepo.On("FindByID", existingCategory.ID, mock.Anything).Return(existingCategory, nil)
+
+	tester.
+		WithHeader("If-Match", `"4"`).
+		WithJSONBody(req).
+		Put(fmt.Sprintf("/api/categories/%d", existingCategory.ID)).
+		SeeStatus(http.StatusPreconditionFailed)
+
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestCategoryHandler_Tree(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewCategoryFactory()
+
+	root := factory.BuildEntity()
+	child := factory.BuildEntity(factory.WithParentID(root.ID))
+
+	mockRepo.On("FindAll", mock.AnythingOfType("*query.CategoryQuery")).
+		Return([]*entity.Category{root, child}, int64(2), nil)
+
+	tester.
+		Get("/api/categories/tree", nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestCategoryHandler_Breadcrumb(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewCategoryFactory()
+
+	root := factory.BuildEntity()
+	child := factory.BuildEntity(factory.WithParentID(root.ID))
+
+	mockRepo.On("FindAll", mock.AnythingOfType("*query.CategoryQuery")).
+		Return([]*entity.Category{root, child}, int64(2), nil)
+
+	tester.
+		Get(fmt.Sprintf("/api/categories/%d/breadcrumb", child.ID), nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestCategoryHandler_Breadcrumb_NotFound(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	mockRepo.On("FindAll", mock.AnythingOfType("*query.CategoryQuery")).
+		Return([]*entity.Category{}, int64(0), nil)
+
+	tester.
+		Get("/api/categories/999/breadcrumb", nil).
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestCategoryHandler_Create_Editor(t *testing.T) {
+	tester, mockRepo := setupTestAs(t, auth.RoleEditor)
+	factory := factory.NewCategoryFactory()
+
+	tester.
+		WithJSONBody(factory.BuildCreateRequest()).
+		Post("/api/categories").
+		SeeStatus(http.StatusForbidden)
+
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCategoryHandler_Update_Editor(t *testing.T) {
+	tester, mockRepo := setupTestAs(t, auth.RoleEditor)
+	factory := factory.NewCategoryFactory()
+
+	tester.
+		WithJSONBody(factory.BuildUpdateRequest()).
+		Put("/api/categories/1").
+		SeeStatus(http.StatusForbidden)
+
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
@@ -0,0 +1,259 @@
+package service_test
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	commentService "github.com/jambo0624/blog/internal/comment/application/service"
+	commentEntity "github.com/jambo0624/blog/internal/comment/domain/entity"
+	"github.com/jambo0624/blog/internal/comment/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/tests/testutil/factory"
+	mockArticle "github.com/jambo0624/blog/tests/testutil/mock/article"
+	mockComment "github.com/jambo0624/blog/tests/testutil/mock/comment"
+)
+
+func setupTest(t *testing.T) (
+	*commentService.CommentService,
+	*mockComment.MockCommentRepository,
+	*mockArticle.MockArticleRepository,
+	*factory.CommentFactory,
+) {
+	t.Helper()
+
+	commentRepo := new(mockComment.MockCommentRepository)
+	articleRepo := new(mockArticle.MockArticleRepository)
+	service := commentService.NewCommentService(commentRepo, articleRepo)
+
+	return service, commentRepo, articleRepo, factory.NewCommentFactory()
+}
+
+func buildArticle(status articleEntity.ArticleStatus) *articleEntity.Article {
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithStatus(status))
+	return article
+}
+
+func TestCommentService_Create(t *testing.T) {
+	service, commentRepo, articleRepo, commentFactory := setupTest(t)
+	article := buildArticle(articleEntity.StatusPublished)
+	parent := commentFactory.BuildEntity(commentFactory.WithArticleID(article.ID))
+
+	req := commentFactory.BuildCreateRequest(func(r *dto.CreateCommentRequest) {
+		r.ArticleID = article.ID
+		r.UserID = 7
+		r.ParentID = &parent.ID
+	})
+
+	articleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	commentRepo.On("FindByID", parent.ID, []string(nil)).Return(parent, nil)
+	commentRepo.On("Save", mock.MatchedBy(func(c *commentEntity.Comment) bool {
+		return c.ArticleID == article.ID && c.UserID == 7 && *c.ParentID == parent.ID
+	})).Return(nil)
+
+	comment, err := service.Create(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, commentEntity.StatusPending, comment.Status)
+	articleRepo.AssertNotCalled(t, "UpdateCommentCount", mock.Anything, mock.Anything)
+}
+
+func TestCommentService_Create_ParentNotApproved(t *testing.T) {
+	for _, status := range []commentEntity.CommentStatus{commentEntity.StatusPending, commentEntity.StatusSpam} {
+		t.Run(string(status), func(t *testing.T) {
+			service, commentRepo, articleRepo, commentFactory := setupTest(t)
+			article := buildArticle(articleEntity.StatusPublished)
+			parent := commentFactory.BuildEntity(
+				commentFactory.WithArticleID(article.ID),
+				commentFactory.WithStatus(status),
+			)
+			req := commentFactory.BuildCreateRequest(func(r *dto.CreateCommentRequest) {
+				r.ArticleID = article.ID
+				r.ParentID = &parent.ID
+			})
+
+			articleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+			commentRepo.On("FindByID", parent.ID, []string(nil)).Return(parent, nil)
+
+			_, err := service.Create(context.Background(), req)
+			require.ErrorIs(t, err, errors.ErrCommentParentHidden)
+			commentRepo.AssertNotCalled(t, "Save", mock.Anything)
+		})
+	}
+}
+
+func TestCommentService_Create_ArticleNotPublished(t *testing.T) {
+	service, commentRepo, articleRepo, commentFactory := setupTest(t)
+	article := buildArticle(articleEntity.StatusDraft)
+	req := commentFactory.BuildCreateRequest(func(r *dto.CreateCommentRequest) {
+		r.ArticleID = article.ID
+	})
+
+	articleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+	_, err := service.Create(context.Background(), req)
+	require.ErrorIs(t, err, errors.ErrCommentsClosed)
+	commentRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCommentService_Create_ArticleNotFound(t *testing.T) {
+	service, _, articleRepo, commentFactory := setupTest(t)
+	req := commentFactory.BuildCreateRequest(func(r *dto.CreateCommentRequest) {
+		r.ArticleID = 99
+	})
+
+	articleRepo.On("FindByID", uint(99), []string(nil)).Return(nil, fmt.Errorf("%w: %w", errors.ErrNotFound, gorm.ErrRecordNotFound))
+
+	_, err := service.Create(context.Background(), req)
+	require.ErrorIs(t, err, errors.ErrArticleNotFound)
+}
+
+func TestCommentService_Create_ArticleLookupFails(t *testing.T) {
+	service, _, articleRepo, commentFactory := setupTest(t)
+	req := commentFactory.BuildCreateRequest(func(r *dto.CreateCommentRequest) {
+		r.ArticleID = 99
+	})
+
+	dbErr := stdErrors.New("connection refused")
+	articleRepo.On("FindByID", uint(99), []string(nil)).Return(nil, dbErr)
+
+	_, err := service.Create(context.Background(), req)
+	require.ErrorIs(t, err, dbErr)
+	assert.NotErrorIs(t, err, errors.ErrArticleNotFound)
+}
+
+func TestCommentService_Moderate_SyncsCommentCount(t *testing.T) {
+	service, commentRepo, articleRepo, commentFactory := setupTest(t)
+	comment := commentFactory.BuildEntity(commentFactory.WithStatus(commentEntity.StatusPending))
+
+	commentRepo.On("FindByID", comment.ID, []string(nil)).Return(comment, nil)
+	commentRepo.On("Update", comment).Return(nil)
+	commentRepo.On("CountApproved", comment.ArticleID).Return(int64(3), nil)
+	articleRepo.On("UpdateCommentCount", comment.ArticleID, int64(3)).Return(nil)
+
+	moderated, err := service.Moderate(context.Background(), comment.ID, &dto.ModerateCommentRequest{Status: "approved"})
+	require.NoError(t, err)
+	assert.True(t, moderated.IsApproved())
+	articleRepo.AssertExpectations(t)
+}
+
+// spyUnitOfWork records the outcome of the work it runs.
+type spyUnitOfWork struct {
+	calls int
+	err   error
+}
+
+func (u *spyUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	u.calls++
+	u.err = fn(ctx)
+	return u.err
+}
+
+func TestCommentService_Moderate_CountFailureRollsBack(t *testing.T) {
+	service, commentRepo, articleRepo, commentFactory := setupTest(t)
+	uow := &spyUnitOfWork{}
+	service.WithUnitOfWork(uow)
+	comment := commentFactory.BuildEntity(commentFactory.WithStatus(commentEntity.StatusPending))
+
+	commentRepo.On("FindByID", comment.ID, []string(nil)).Return(comment, nil)
+	commentRepo.On("Update", comment).Return(nil)
+	commentRepo.On("CountApproved", comment.ArticleID).Return(int64(3), nil)
+	articleRepo.On("UpdateCommentCount", comment.ArticleID, int64(3)).Return(gorm.ErrInvalidDB)
+
+	_, err := service.Moderate(context.Background(), comment.ID, &dto.ModerateCommentRequest{Status: "approved"})
+	require.ErrorIs(t, err, gorm.ErrInvalidDB)
+	assert.Equal(t, 1, uow.calls)
+	assert.ErrorIs(t, uow.err, gorm.ErrInvalidDB, "the failure must reach the unit of work so it rolls back")
+}
+
+func TestCommentService_Delete_CountFailureRollsBack(t *testing.T) {
+	service, commentRepo, articleRepo, commentFactory := setupTest(t)
+	uow := &spyUnitOfWork{}
+	service.WithUnitOfWork(uow)
+	comment := commentFactory.BuildEntity()
+
+	commentRepo.On("FindByID", comment.ID, []string(nil)).Return(comment, nil)
+	commentRepo.On("Delete", comment.ID).Return(nil)
+	commentRepo.On("CountApproved", comment.ArticleID).Return(int64(0), gorm.ErrInvalidDB)
+
+	err := service.Delete(context.Background(), comment.ID)
+	require.ErrorIs(t, err, gorm.ErrInvalidDB)
+	assert.Equal(t, 1, uow.calls)
+	assert.ErrorIs(t, uow.err, gorm.ErrInvalidDB, "the failure must reach the unit of work so it rolls back")
+	articleRepo.AssertNotCalled(t, "UpdateCommentCount", mock.Anything, mock.Anything)
+}
+
+func TestCommentService_Moderate_UnchangedVisibility(t *testing.T) {
+	service, commentRepo, articleRepo, commentFactory := setupTest(t)
+	comment := commentFactory.BuildEntity(commentFactory.WithStatus(commentEntity.StatusPending))
+
+	commentRepo.On("FindByID", comment.ID, []string(nil)).Return(comment, nil)
+	commentRepo.On("Update", comment).Return(nil)
+
+	_, err := service.Moderate(context.Background(), comment.ID, &dto.ModerateCommentRequest{Status: "spam"})
+	require.NoError(t, err)
+	articleRepo.AssertNotCalled(t, "UpdateCommentCount", mock.Anything, mock.Anything)
+}
+
+func TestCommentService_Delete_SyncsCommentCount(t *testing.T) {
+	service, commentRepo, articleRepo, commentFactory := setupTest(t)
+	comment := commentFactory.BuildEntity()
+
+	commentRepo.On("FindByID", comment.ID, []string(nil)).Return(comment, nil)
+	commentRepo.On("Delete", comment.ID).Return(nil)
+	commentRepo.On("CountApproved", comment.ArticleID).Return(int64(0), nil)
+	articleRepo.On("UpdateCommentCount", comment.ArticleID, int64(0)).Return(nil)
+
+	require.NoError(t, service.Delete(context.Background(), comment.ID))
+	articleRepo.AssertExpectations(t)
+}
+
+func TestCommentService_Delete_LookupFails(t *testing.T) {
+	service, commentRepo, _, _ := setupTest(t)
+
+	dbErr := stdErrors.New("connection refused")
+	commentRepo.On("FindByID", uint(5), []string(nil)).Return(nil, dbErr)
+
+	err := service.Delete(context.Background(), 5)
+	require.ErrorIs(t, err, dbErr)
+	assert.NotErrorIs(t, err, errors.ErrCommentNotFound)
+	commentRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestCommentService_Thread(t *testing.T) {
+	service, commentRepo, _, commentFactory := setupTest(t)
+	root := commentFactory.BuildEntity()
+	reply := commentFactory.BuildEntity(commentFactory.WithParent(root))
+
+	commentRepo.On("FindApprovedByArticleID", root.ArticleID).
+		Return([]*commentEntity.Comment{root, reply}, nil)
+
+	thread, err := service.Thread(context.Background(), root.ArticleID)
+	require.NoError(t, err)
+	require.Len(t, thread, 1)
+	assert.Equal(t, []*commentEntity.Comment{reply}, thread[0].Replies)
+}
+
+func TestCommentService_Authorize(t *testing.T) {
+	service, commentRepo, _, commentFactory := setupTest(t)
+	comment := commentFactory.BuildEntity(commentFactory.WithUserID(7))
+	commentRepo.On("FindByID", comment.ID, []string(nil)).Return(comment, nil)
+
+	owner := &auth.Principal{UserID: 7, Role: auth.RoleReader}
+	other := &auth.Principal{UserID: 8, Role: auth.RoleReader}
+	editor := &auth.Principal{UserID: 9, Role: auth.RoleEditor}
+
+	require.NoError(t, service.Authorize(context.Background(), owner, auth.ActionUpdate, comment.ID))
+	require.ErrorIs(t, service.Authorize(context.Background(), other, auth.ActionDelete, comment.ID), errors.ErrForbidden)
+	require.NoError(t, service.Authorize(context.Background(), editor, auth.ActionDelete, comment.ID))
+	require.ErrorIs(t, service.Authorize(context.Background(), owner, auth.ActionModerate, comment.ID), errors.ErrForbidden)
+	require.NoError(t, service.Authorize(context.Background(), editor, auth.ActionModerate, comment.ID))
+}
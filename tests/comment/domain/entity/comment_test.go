@@ -0,0 +1,102 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/comment/domain/entity"
+	"github.com/jambo0624/blog/internal/comment/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+var now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func TestNewComment(t *testing.T) {
+	parent := &entity.Comment{ID: 3, ArticleID: 1, Status: entity.StatusApproved}
+	otherArticle := &entity.Comment{ID: 4, ArticleID: 2, Status: entity.StatusApproved}
+	pending := &entity.Comment{ID: 5, ArticleID: 1, Status: entity.StatusPending}
+
+	tests := []struct {
+		name    string
+		parent  *entity.Comment
+		content string
+		wantErr error
+	}{
+		{name: "top level", content: "Nice post"},
+		{name: "reply", parent: parent, content: "Agreed"},
+		{name: "empty content", content: "   ", wantErr: errors.ErrContentRequired},
+		{name: "content too long", content: strings.Repeat("a", 2001), wantErr: errors.ErrContentTooLong},
+		{name: "parent on another article", parent: otherArticle, content: "Hi", wantErr: errors.ErrCommentParentMismatch},
+		{name: "parent not approved", parent: pending, content: "Hi", wantErr: errors.ErrCommentParentHidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment, err := entity.NewComment(1, 7, tt.parent, tt.content, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entity.StatusPending, comment.Status)
+			assert.Equal(t, uint(7), comment.UserID)
+			assert.Equal(t, strings.TrimSpace(tt.content), comment.Content)
+			if tt.parent != nil {
+				assert.Equal(t, tt.parent.ID, *comment.ParentID)
+			} else {
+				assert.Nil(t, comment.ParentID)
+			}
+		})
+	}
+}
+
+func TestComment_Update_RequeuesForModeration(t *testing.T) {
+	comment := &entity.Comment{Content: "first", Status: entity.StatusApproved}
+
+	require.NoError(t, comment.Update(&dto.UpdateCommentRequest{Content: "first"}, now))
+	assert.Equal(t, entity.StatusApproved, comment.Status)
+
+	require.NoError(t, comment.Update(&dto.UpdateCommentRequest{Content: "second"}, now))
+	assert.Equal(t, "second", comment.Content)
+	assert.Equal(t, entity.StatusPending, comment.Status)
+}
+
+func TestComment_Moderate(t *testing.T) {
+	comment := &entity.Comment{Status: entity.StatusPending}
+
+	require.NoError(t, comment.Moderate(entity.StatusApproved, now))
+	assert.True(t, comment.IsApproved())
+	assert.Equal(t, now, *comment.ModeratedAt)
+
+	require.NoError(t, comment.Moderate(entity.StatusSpam, now))
+	assert.False(t, comment.IsApproved())
+
+	assert.ErrorIs(t, comment.Moderate(entity.CommentStatus("deleted"), now), errors.ErrInvalidStatus)
+	assert.Equal(t, entity.StatusSpam, comment.Status)
+}
+
+func TestThread(t *testing.T) {
+	id := func(v uint) *uint { return &v }
+	comments := []*entity.Comment{
+		{ID: 1},
+		{ID: 2, ParentID: id(1)},
+		{ID: 3},
+		{ID: 4, ParentID: id(2)},
+		{ID: 5, ParentID: id(99)},
+	}
+
+	roots := entity.Thread(comments)
+
+	require.Len(t, roots, 2)
+	assert.Equal(t, uint(1), roots[0].ID)
+	assert.Equal(t, uint(3), roots[1].ID)
+	require.Len(t, roots[0].Replies, 1)
+	assert.Equal(t, uint(2), roots[0].Replies[0].ID)
+	require.Len(t, roots[0].Replies[0].Replies, 1)
+	assert.Equal(t, uint(4), roots[0].Replies[0].Replies[0].ID)
+	assert.Empty(t, roots[1].Replies)
+}
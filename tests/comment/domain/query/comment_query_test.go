@@ -0,0 +1,66 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/comment/domain/entity"
+	"github.com/jambo0624/blog/internal/comment/domain/query"
+	"github.com/jambo0624/blog/tests/testutil"
+)
+
+func TestCommentQuery_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   func() *query.CommentQuery
+		wantErr bool
+	}{
+		{
+			name: "valid query",
+			query: func() *query.CommentQuery {
+				return query.NewCommentQuery().WithArticleID(1).WithStatuses("approved")
+			},
+		},
+		{
+			name: "invalid status",
+			query: func() *query.CommentQuery {
+				return query.NewCommentQuery().WithStatuses("deleted")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query().Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommentQuery_ApplyFilters(t *testing.T) {
+	testDB, cleanup := testutil.SetupTestDB(t)
+	defer cleanup()
+
+	q := query.NewCommentQuery().
+		WithArticleID(1).
+		WithUserID(7).
+		WithStatuses("pending")
+
+	db := q.ApplyFilters(testDB.DB.Model(&entity.Comment{}))
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		var comments []*entity.Comment
+		return tx.Find(&comments)
+	})
+
+	assert.Contains(t, sql, "comments.article_id = 1")
+	assert.Contains(t, sql, "comments.user_id = 7")
+	assert.Contains(t, sql, "comments.status IN ('pending')")
+}
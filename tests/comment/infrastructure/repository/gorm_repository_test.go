@@ -0,0 +1,55 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commentEntity "github.com/jambo0624/blog/internal/comment/domain/entity"
+	commentPersistence "github.com/jambo0624/blog/internal/comment/infrastructure/repository"
+	userPersistence "github.com/jambo0624/blog/internal/user/infrastructure/repository"
+	"github.com/jambo0624/blog/tests/testutil"
+	"github.com/jambo0624/blog/tests/testutil/factory"
+)
+
+func TestGormCommentRepository_Approved(t *testing.T) {
+	testDB, cleanup := testutil.SetupTestDB(t)
+	defer cleanup()
+
+	user := factory.NewUserFactory().BuildEntity()
+	user.ID = 0
+	require.NoError(t, userPersistence.NewGormUserRepository(testDB.DB).Save(context.Background(), user))
+
+	repo := commentPersistence.NewGormCommentRepository(testDB.DB)
+	article := testDB.Data.Articles[0]
+	now := time.Now()
+
+	root, err := commentEntity.NewComment(article.ID, user.ID, nil, "First!", now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), root))
+	require.NoError(t, root.Moderate(commentEntity.StatusApproved, now))
+	require.NoError(t, repo.Update(context.Background(), root))
+
+	reply, err := commentEntity.NewComment(article.ID, user.ID, root, "Second", now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), reply))
+
+	count, err := repo.CountApproved(context.Background(), article.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	comments, err := repo.FindApprovedByArticleID(context.Background(), article.ID)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, root.ID, comments[0].ID)
+	require.NotNil(t, comments[0].Author)
+	assert.Equal(t, user.Name, comments[0].Author.DisplayName)
+
+	require.NoError(t, repo.Delete(context.Background(), root.ID))
+	count, err = repo.CountApproved(context.Background(), article.ID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,215 @@
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	commentService "github.com/jambo0624/blog/internal/comment/application/service"
+	commentEntity "github.com/jambo0624/blog/internal/comment/domain/entity"
+	commentQuery "github.com/jambo0624/blog/internal/comment/domain/query"
+	commentHandler "github.com/jambo0624/blog/internal/comment/interfaces/http"
+	"github.com/jambo0624/blog/internal/comment/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/tests/testutil"
+	"github.com/jambo0624/blog/tests/testutil/factory"
+	mockArticle "github.com/jambo0624/blog/tests/testutil/mock/article"
+	mockComment "github.com/jambo0624/blog/tests/testutil/mock/comment"
+)
+
+// setupTest authenticates every request as the given user and role.
+func setupTest(t *testing.T, userID uint, role auth.Role) (
+	*testutil.HTTPTester,
+	*mockComment.MockCommentRepository,
+	*mockArticle.MockArticleRepository,
+) {
+	t.Helper()
+
+	commentRepo := new(mockComment.MockCommentRepository)
+	articleRepo := new(mockArticle.MockArticleRepository)
+	service := commentService.NewCommentService(commentRepo, articleRepo)
+	handler := commentHandler.NewCommentHandler(service)
+	authenticator := testutil.NewFakeAuthenticator(userID, role)
+	router := commentHandler.NewCommentRouter(handler, authenticator)
+
+	tester := testutil.NewHTTPTester(t, testutil.WithAuth(authenticator, router.Register)).
+		WithHeader("Authorization", "Bearer "+testutil.TestToken)
+
+	return tester, commentRepo, articleRepo
+}
+
+func TestCommentHandler_Create(t *testing.T) {
+	tester, commentRepo, articleRepo := setupTest(t, 7, auth.RoleReader)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithStatus(articleEntity.StatusPublished))
+	req := factory.NewCommentFactory().BuildCreateRequest()
+
+	articleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+	commentRepo.On("Save", mock.MatchedBy(func(c *commentEntity.Comment) bool {
+		return c.ArticleID == article.ID && c.UserID == 7 && c.Status == commentEntity.StatusPending
+	})).Return(nil)
+
+	tester.
+		WithJSONBody(req).
+		Post(fmt.Sprintf("/api/articles/%d/comments", article.ID)).
+		SeeStatus(http.StatusCreated)
+}
+
+func TestCommentHandler_Create_CommentsClosed(t *testing.T) {
+	tester, commentRepo, articleRepo := setupTest(t, 7, auth.RoleReader)
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	article, _, _ := articleFactory.BuildEntity(articleFactory.WithStatus(articleEntity.StatusDraft))
+
+	articleRepo.On("FindByID", article.ID, []string(nil)).Return(article, nil)
+
+	tester.
+		WithJSONBody(factory.NewCommentFactory().BuildCreateRequest()).
+		Post(fmt.Sprintf("/api/articles/%d/comments", article.ID)).
+		SeeStatus(http.StatusConflict)
+
+	commentRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCommentHandler_Create_Unauthenticated(t *testing.T) {
+	tester, _, _ := setupTest(t, 7, auth.RoleReader)
+
+	tester.
+		WithHeader("Authorization", "").
+		WithJSONBody(factory.NewCommentFactory().BuildCreateRequest()).
+		Post("/api/articles/1/comments").
+		SeeStatus(http.StatusUnauthorized)
+}
+
+func TestCommentHandler_Thread(t *testing.T) {
+	tester, commentRepo, _ := setupTest(t, 7, auth.RoleReader)
+	commentFactory := factory.NewCommentFactory()
+	root := commentFactory.BuildEntity()
+	reply := commentFactory.BuildEntity(commentFactory.WithParent(root))
+
+	commentRepo.On("FindApprovedByArticleID", root.ArticleID).
+		Return([]*commentEntity.Comment{root, reply}, nil)
+
+	tester.
+		WithHeader("Authorization", "").
+		Get(fmt.Sprintf("/api/articles/%d/comments", root.ArticleID), nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestCommentHandler_List_ApprovedOnly(t *testing.T) {
+	tester, commentRepo, _ := setupTest(t, 7, auth.RoleReader)
+	comments := factory.NewCommentFactory().BuildList(2)
+
+	commentRepo.On("FindAll", mock.MatchedBy(func(q *commentQuery.CommentQuery) bool {
+		return len(q.Statuses) == 1 && q.Statuses[0] == string(commentEntity.StatusApproved) &&
+			q.ArticleID != nil && *q.ArticleID == 1
+	})).Return(comments, int64(len(comments)), nil)
+
+	tester.
+		Get("/api/comments", map[string]string{"article_id": "1"}).
+		SeeStatus(http.StatusOK)
+}
+
+func TestCommentHandler_FindByID_Pending(t *testing.T) {
+	tester, commentRepo, _ := setupTest(t, 7, auth.RoleReader)
+	commentFactory := factory.NewCommentFactory()
+	comment := commentFactory.BuildEntity(commentFactory.WithStatus(commentEntity.StatusPending))
+
+	commentRepo.On("FindByID", comment.ID, mock.Anything).Return(comment, nil)
+
+	tester.
+		Get(fmt.Sprintf("/api/comments/%d", comment.ID), nil).
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestCommentHandler_Update_NotOwner(t *testing.T) {
+	tester, commentRepo, _ := setupTest(t, 7, auth.RoleReader)
+	commentFactory := factory.NewCommentFactory()
+	comment := commentFactory.BuildEntity(commentFactory.WithUserID(8))
+
+	commentRepo.On("FindByID", comment.ID, []string(nil)).Return(comment, nil)
+
+	tester.
+		WithJSONBody(commentFactory.BuildUpdateRequest()).
+		Put(fmt.Sprintf("/api/comments/%d", comment.ID)).
+		SeeStatus(http.StatusForbidden)
+
+	commentRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestCommentHandler_Delete_Owner(t *testing.T) {
+	tester, commentRepo, articleRepo := setupTest(t, 7, auth.RoleReader)
+	commentFactory := factory.NewCommentFactory()
+	comment := commentFactory.BuildEntity(commentFactory.WithUserID(7))
+
+	commentRepo.On("FindByID", comment.ID, []string(nil)).Return(comment, nil)
+	commentRepo.On("Delete", comment.ID).Return(nil)
+	commentRepo.On("CountApproved", comment.ArticleID).Return(int64(0), nil)
+	articleRepo.On("UpdateCommentCount", comment.ArticleID, int64(0)).Return(nil)
+
+	tester.
+		Delete(fmt.Sprintf("/api/comments/%d", comment.ID)).
+		SeeStatus(http.StatusNoContent)
+}
+
+func TestCommentHandler_ModerationQueue(t *testing.T) {
+	tester, commentRepo, _ := setupTest(t, 9, auth.RoleEditor)
+	commentFactory := factory.NewCommentFactory()
+	pending := []*commentEntity.Comment{commentFactory.BuildEntity(commentFactory.WithStatus(commentEntity.StatusPending))}
+
+	commentRepo.On("FindAll", mock.MatchedBy(func(q *commentQuery.CommentQuery) bool {
+		return len(q.Statuses) == 1 && q.Statuses[0] == string(commentEntity.StatusPending)
+	})).Return(pending, int64(len(pending)), nil)
+
+	tester.
+		Get("/api/comments/moderation", nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestCommentHandler_ModerationQueue_Reader(t *testing.T) {
+	tester, commentRepo, _ := setupTest(t, 7, auth.RoleReader)
+
+	tester.
+		Get("/api/comments/moderation", nil).
+		SeeStatus(http.StatusForbidden)
+
+	commentRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+}
+
+func TestCommentHandler_ModerationQueue_Unauthenticated(t *testing.T) {
+	tester, _, _ := setupTest(t, 9, auth.RoleEditor)
+
+	tester.
+		WithHeader("Authorization", "").
+		Get("/api/comments/moderation", nil).
+		SeeStatus(http.StatusUnauthorized)
+}
+
+func TestCommentHandler_Moderate(t *testing.T) {
+	tester, commentRepo, articleRepo := setupTest(t, 9, auth.RoleEditor)
+	commentFactory := factory.NewCommentFactory()
+	comment := commentFactory.BuildEntity(commentFactory.WithStatus(commentEntity.StatusPending))
+
+	commentRepo.On("FindByID", comment.ID, []string(nil)).Return(comment, nil)
+	commentRepo.On("Update", mock.MatchedBy(func(c *commentEntity.Comment) bool {
+		return c.ID == comment.ID && c.IsApproved()
+	})).Return(nil)
+	commentRepo.On("CountApproved", comment.ArticleID).Return(int64(1), nil)
+	articleRepo.On("UpdateCommentCount", comment.ArticleID, int64(1)).Return(nil)
+
+	tester.
+		WithJSONBody(&dto.ModerateCommentRequest{Status: "approved"}).
+		Post(fmt.Sprintf("/api/comments/%d/moderate", comment.ID)).
+		SeeStatus(http.StatusOK)
+}
+
+func TestCommentHandler_Moderate_InvalidStatus(t *testing.T) {
+	tester, _, _ := setupTest(t, 9, auth.RoleEditor)
+
+	tester.
+		WithJSONBody(&dto.ModerateCommentRequest{Status: "deleted"}).
+		Post("/api/comments/1/moderate").
+		SeeStatus(http.StatusUnprocessableEntity)
+}
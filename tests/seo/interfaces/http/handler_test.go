@@ -0,0 +1,180 @@
+package http_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	articleService "github.com/jambo0624/blog/internal/article/application/service"
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+	categoryService "github.com/jambo0624/blog/internal/category/application/service"
+	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
+	categoryQuery "github.com/jambo0624/blog/internal/category/domain/query"
+	seoHandler "github.com/jambo0624/blog/internal/seo/interfaces/http"
+	"github.com/jambo0624/blog/internal/seo/interfaces/http/sitemap"
+	tagService "github.com/jambo0624/blog/internal/tag/application/service"
+	tagEntity "github.com/jambo0624/blog/internal/tag/domain/entity"
+	tagQuery "github.com/jambo0624/blog/internal/tag/domain/query"
+	"github.com/jambo0624/blog/tests/testutil"
+	"github.com/jambo0624/blog/tests/testutil/factory"
+	mockArticle "github.com/jambo0624/blog/tests/testutil/mock/article"
+	mockCategory "github.com/jambo0624/blog/tests/testutil/mock/category"
+	mockTag "github.com/jambo0624/blog/tests/testutil/mock/tag"
+)
+
+type seoMocks struct {
+	article  *mockArticle.MockArticleRepository
+	category *mockCategory.MockCategoryRepository
+	tag      *mockTag.MockTagRepository
+}
+
+func setupTest(t *testing.T, options seoHandler.SEOOptions) (*testutil.HTTPTester, seoMocks) {
+	t.Helper()
+	mocks := seoMocks{
+		article:  new(mockArticle.MockArticleRepository),
+		category: new(mockCategory.MockCategoryRepository),
+		tag:      new(mockTag.MockTagRepository),
+	}
+
+	handler := seoHandler.NewSEOHandler(
+		articleService.NewArticleService(mocks.article, mocks.category, mocks.tag, new(mockArticle.MockArticleRevisionRepository)),
+		categoryService.NewCategoryService(mocks.category),
+		tagService.NewTagService(mocks.tag),
+		options,
+	)
+	tester := testutil.NewHTTPTester(t, seoHandler.NewSEORouter(handler).Register)
+
+	return tester, mocks
+}
+
+// expectContent makes every listing return its entities, with the totals
+// for the count queries.
+func (m seoMocks) expectContent(
+	articles []*articleEntity.Article,
+	categories []*categoryEntity.Category,
+	tags []*tagEntity.Tag,
+) {
+	links := make([]*articleEntity.ArticleLink, 0, len(articles))
+	for _, article := range articles {
+		links = append(links, &articleEntity.ArticleLink{ID: article.ID, Slug: article.Slug, UpdatedAt: article.UpdatedAt})
+	}
+	m.article.On("CountPublished").Return(int64(len(links)), nil)
+	m.article.On("FindPublishedLinks", mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(links, nil)
+
+	m.category.On("FindAll", mock.MatchedBy(func(q *categoryQuery.CategoryQuery) bool {
+		return !q.SkipCount
+	})).Return(categories[:1], int64(len(categories)), nil)
+	m.category.On("FindAll", mock.MatchedBy(func(q *categoryQuery.CategoryQuery) bool {
+		return q.SkipCount
+	})).Return(categories, int64(0), nil)
+
+	m.tag.On("FindAll", mock.MatchedBy(func(q *tagQuery.TagQuery) bool {
+		return !q.SkipCount
+	})).Return(tags[:1], int64(len(tags)), nil)
+	m.tag.On("FindAll", mock.MatchedBy(func(q *tagQuery.TagQuery) bool {
+		return q.SkipCount
+	})).Return(tags, int64(0), nil)
+}
+
+func buildContent() ([]*articleEntity.Article, []*categoryEntity.Category, []*tagEntity.Tag) {
+	articleFactory := factory.NewArticleFactory(factory.NewCategoryFactory(), factory.NewTagFactory())
+	return articleFactory.BuildList(2), factory.NewCategoryFactory().BuildList(1), factory.NewTagFactory().BuildList(1)
+}
+
+func TestSEOHandler_Sitemap(t *testing.T) {
+	tester, mocks := setupTest(t, seoHandler.SEOOptions{SiteURL: "https://example.com"})
+	articles, categories, tags := buildContent()
+	mocks.expectContent(articles, categories, tags)
+
+	body := tester.
+		Get("/api/sitemap.xml", nil).
+		SeeStatus(http.StatusOK).
+		SeeHeader("Content-Type", sitemap.ContentType).
+		Body()
+
+	assert.Contains(t, body, "<urlset")
+	assert.Equal(t, 4, strings.Count(body, "<url>"))
+	assert.Contains(t, body, "<loc>https://example.com/articles/"+articles[0].Slug+"</loc>")
+	assert.Contains(t, body, "<loc>https://example.com/categories/"+categories[0].Slug+"</loc>")
+}
+
+func TestSEOHandler_SitemapIndex(t *testing.T) {
+	tester, mocks := setupTest(t, seoHandler.SEOOptions{SiteURL: "https://example.com", SitemapSize: 1})
+	articles, categories, tags := buildContent()
+	mocks.expectContent(articles, categories, tags)
+
+	body := tester.
+		Get("/api/sitemap.xml", nil).
+		SeeStatus(http.StatusOK).
+		Body()
+
+	assert.Contains(t, body, "<sitemapindex")
+	assert.Contains(t, body, "<loc>https://example.com/sitemaps/articles-1.xml</loc>")
+	assert.Contains(t, body, "<loc>https://example.com/sitemaps/articles-2.xml</loc>")
+	assert.Contains(t, body, "<loc>https://example.com/sitemaps/categories-1.xml</loc>")
+	assert.Contains(t, body, "<loc>https://example.com/sitemaps/tags-1.xml</loc>")
+}
+
+func TestSEOHandler_SitemapPage(t *testing.T) {
+	tester, mocks := setupTest(t, seoHandler.SEOOptions{SiteURL: "https://example.com", SitemapSize: 1})
+	articles, categories, tags := buildContent()
+	mocks.expectContent(articles, categories, tags)
+
+	tester.
+		Get("/api/sitemaps/tags-1.xml", nil).
+		SeeStatus(http.StatusOK)
+	mocks.tag.AssertCalled(t, "FindAll", mock.MatchedBy(func(q *tagQuery.TagQuery) bool {
+		return q.SkipCount && q.Limit == 1 && q.Offset == 0
+	}))
+
+	tester.
+		Get("/api/sitemaps/users-1.xml", nil).
+		SeeStatus(http.StatusNotFound)
+	tester.
+		Get("/api/sitemaps/articles-0.xml", nil).
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestSEOHandler_SitemapPage_PastTheEnd(t *testing.T) {
+	tester, mocks := setupTest(t, seoHandler.SEOOptions{SiteURL: "https://example.com"})
+	mocks.article.On("FindPublishedLinks", 50000, 100000).Return([]*articleEntity.ArticleLink{}, nil)
+
+	tester.
+		Get("/api/sitemaps/articles-3.xml", nil).
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestSEOHandler_Robots(t *testing.T) {
+	tester, _ := setupTest(t, seoHandler.SEOOptions{
+		SiteURL:        "https://example.com",
+		RobotsAllow:    []string{"/api/feed.xml"},
+		RobotsDisallow: []string{"/api/", "/admin/"},
+	})
+
+	body := tester.
+		Get("/api/robots.txt", nil).
+		SeeStatus(http.StatusOK).
+		SeeHeader("Content-Type", "text/plain; charset=utf-8").
+		Body()
+
+	assert.Equal(t, "User-agent: *\n"+
+		"Allow: /api/feed.xml\n"+
+		"Disallow: /api/\n"+
+		"Disallow: /admin/\n"+
+		"\n"+
+		"Sitemap: https://example.com/sitemap.xml\n", body)
+}
+
+func TestSEOHandler_Robots_AllowAll(t *testing.T) {
+	tester, _ := setupTest(t, seoHandler.SEOOptions{SiteURL: "https://example.com"})
+
+	body := tester.
+		Get("/api/robots.txt", nil).
+		SeeStatus(http.StatusOK).
+		Body()
+
+	assert.Contains(t, body, "Disallow:\n")
+}
@@ -0,0 +1,48 @@
+package sitemap_test
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/seo/interfaces/http/sitemap"
+)
+
+func TestURLSet(t *testing.T) {
+	body, err := sitemap.URLSet([]sitemap.URL{
+		{Loc: "https://example.com/articles/1", LastMod: time.Date(2024, 5, 1, 14, 0, 0, 0, time.FixedZone("CEST", 2*60*60))},
+		{Loc: "https://example.com/tags/2"},
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+		URLs    []struct {
+			Loc     string `xml:"loc"`
+			LastMod string `xml:"lastmod"`
+		} `xml:"url"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc))
+	require.Len(t, doc.URLs, 2)
+	assert.Equal(t, "https://example.com/articles/1", doc.URLs[0].Loc)
+	assert.Equal(t, "2024-05-01T12:00:00Z", doc.URLs[0].LastMod)
+	assert.Empty(t, doc.URLs[1].LastMod)
+}
+
+func TestIndex(t *testing.T) {
+	body, err := sitemap.Index([]sitemap.URL{{Loc: "https://example.com/sitemaps/articles-1.xml"}})
+	require.NoError(t, err)
+
+	var doc struct {
+		XMLName  xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+		Sitemaps []struct {
+			Loc string `xml:"loc"`
+		} `xml:"sitemap"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc))
+	require.Len(t, doc.Sitemaps, 1)
+	assert.Equal(t, "https://example.com/sitemaps/articles-1.xml", doc.Sitemaps[0].Loc)
+}
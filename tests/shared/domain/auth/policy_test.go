@@ -0,0 +1,108 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+)
+
+func principal(userID uint, role auth.Role) *auth.Principal {
+	return &auth.Principal{UserID: userID, Role: role, Method: auth.MethodJWT}
+}
+
+func TestPolicy_Authorize(t *testing.T) {
+	policy := auth.NewPolicy()
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		action    auth.Action
+		resource  auth.Resource
+		wantErr   error
+	}{
+		{"anonymous reads articles", nil, auth.ActionRead, auth.ResourceArticle, nil},
+		{"anonymous reads tags", nil, auth.ActionRead, auth.ResourceTag, nil},
+		{"anonymous creates article", nil, auth.ActionCreate, auth.ResourceArticle, errors.ErrUnauthenticated},
+		{"anonymous reads users", nil, auth.ActionRead, auth.ResourceUser, errors.ErrUnauthenticated},
+		{"reader creates article", principal(1, auth.RoleReader), auth.ActionCreate, auth.ResourceArticle, errors.ErrForbidden},
+		{"author creates article", principal(1, auth.RoleAuthor), auth.ActionCreate, auth.ResourceArticle, nil},
+		{"author publishes article", principal(1, auth.RoleAuthor), auth.ActionPublish, auth.ResourceArticle, errors.ErrForbidden},
+		{"editor publishes article", principal(1, auth.RoleEditor), auth.ActionPublish, auth.ResourceArticle, nil},
+		{"editor creates category", principal(1, auth.RoleEditor), auth.ActionCreate, auth.ResourceCategory, errors.ErrForbidden},
+		{"editor deletes tag", principal(1, auth.RoleEditor), auth.ActionDelete, auth.ResourceTag, errors.ErrForbidden},
+		{"editor reads users", principal(1, auth.RoleEditor), auth.ActionRead, auth.ResourceUser, errors.ErrForbidden},
+		{"admin creates category", principal(1, auth.RoleAdmin), auth.ActionCreate, auth.ResourceCategory, nil},
+		{"admin deletes user", principal(1, auth.RoleAdmin), auth.ActionDelete, auth.ResourceUser, nil},
+		{"unknown role", principal(1, auth.Role("owner")), auth.ActionCreate, auth.ResourceArticle, errors.ErrForbidden},
+		{"anonymous reads comments", nil, auth.ActionRead, auth.ResourceComment, nil},
+		{"anonymous comments", nil, auth.ActionCreate, auth.ResourceComment, errors.ErrUnauthenticated},
+		{"reader comments", principal(1, auth.RoleReader), auth.ActionCreate, auth.ResourceComment, nil},
+		{"reader moderates comments", principal(1, auth.RoleReader), auth.ActionModerate, auth.ResourceComment, errors.ErrForbidden},
+		{"author moderates comments", principal(1, auth.RoleAuthor), auth.ActionModerate, auth.ResourceComment, errors.ErrForbidden},
+		{"editor moderates comments", principal(1, auth.RoleEditor), auth.ActionModerate, auth.ResourceComment, nil},
+		{"author restores article", principal(1, auth.RoleAuthor), auth.ActionRestore, auth.ResourceArticle, errors.ErrForbidden},
+		{"editor restores article", principal(1, auth.RoleEditor), auth.ActionRestore, auth.ResourceArticle, nil},
+		{"editor restores tag", principal(1, auth.RoleEditor), auth.ActionRestore, auth.ResourceTag, errors.ErrForbidden},
+		{"editor purges article", principal(1, auth.RoleEditor), auth.ActionPurge, auth.ResourceArticle, errors.ErrForbidden},
+		{"admin purges article", principal(1, auth.RoleAdmin), auth.ActionPurge, auth.ResourceArticle, nil},
+		{"anonymous reads drafts", nil, auth.ActionReadUnpublished, auth.ResourceArticle, errors.ErrUnauthenticated},
+		{"reader reads drafts", principal(1, auth.RoleReader), auth.ActionReadUnpublished, auth.ResourceArticle, errors.ErrForbidden},
+		{"editor reads drafts", principal(1, auth.RoleEditor), auth.ActionReadUnpublished, auth.ResourceArticle, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Authorize(tt.principal, tt.action, tt.resource)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPolicy_AuthorizeOwned(t *testing.T) {
+	policy := auth.NewPolicy()
+	owner := uint(1)
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		action    auth.Action
+		ownerID   *uint
+		wantErr   error
+	}{
+		{"author updates own article", principal(1, auth.RoleAuthor), auth.ActionUpdate, &owner, nil},
+		{"author deletes own article", principal(1, auth.RoleAuthor), auth.ActionDelete, &owner, nil},
+		{"author updates another's article", principal(2, auth.RoleAuthor), auth.ActionUpdate, &owner, errors.ErrForbidden},
+		{"author updates unowned article", principal(1, auth.RoleAuthor), auth.ActionUpdate, nil, errors.ErrForbidden},
+		{"editor updates another's article", principal(2, auth.RoleEditor), auth.ActionUpdate, &owner, nil},
+		{"admin deletes unowned article", principal(2, auth.RoleAdmin), auth.ActionDelete, nil, nil},
+		{"reader updates own article", principal(1, auth.RoleReader), auth.ActionUpdate, &owner, errors.ErrForbidden},
+		{"author reads own draft", principal(1, auth.RoleAuthor), auth.ActionReadUnpublished, &owner, nil},
+		{"author reads another's draft", principal(2, auth.RoleAuthor), auth.ActionReadUnpublished, &owner, errors.ErrForbidden},
+		{"editor reads another's draft", principal(2, auth.RoleEditor), auth.ActionReadUnpublished, &owner, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.AuthorizeOwned(tt.principal, tt.action, auth.ResourceArticle, tt.ownerID)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRole_IsValid(t *testing.T) {
+	assert.True(t, auth.RoleAdmin.IsValid())
+	assert.True(t, auth.RoleReader.IsValid())
+	assert.False(t, auth.Role("owner").IsValid())
+	assert.False(t, auth.Role("").IsValid())
+}
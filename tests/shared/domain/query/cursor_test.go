@@ -0,0 +1,126 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/domain/query"
+)
+
+type post struct {
+	ID          uint
+	Title       string
+	PublishedAt *time.Time
+	CreatedAt   time.Time
+}
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	value := "2024-01-02T03:04:05Z"
+	cursor := &query.Cursor{Field: "created_at", Desc: true, Value: &value, ID: 42}
+
+	token, err := cursor.Encode()
+	require.NoError(t, err)
+
+	decoded, err := query.DecodeCursor(token)
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "!!!"},
+		{name: "not json", token: "bm90LWpzb24"},
+		{name: "missing id", token: "eyJmIjoiaWQifQ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := query.DecodeCursor(tt.token)
+			require.ErrorIs(t, err, errors.ErrInvalidCursor)
+		})
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy      string
+		expectedName string
+		expectedDesc bool
+	}{
+		{orderBy: "", expectedName: "id", expectedDesc: false},
+		{orderBy: "title", expectedName: "title", expectedDesc: false},
+		{orderBy: "-created_at", expectedName: "created_at", expectedDesc: true},
+		{orderBy: "created_at DESC", expectedName: "created_at", expectedDesc: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.orderBy, func(t *testing.T) {
+			field, desc := query.ParseOrderBy(tt.orderBy)
+			assert.Equal(t, tt.expectedName, field)
+			assert.Equal(t, tt.expectedDesc, desc)
+		})
+	}
+}
+
+func TestNextCursor(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	posts := []*post{
+		{ID: 1, Title: "first", CreatedAt: createdAt},
+		{ID: 2, Title: "second", CreatedAt: createdAt},
+	}
+
+	t.Run("full page", func(t *testing.T) {
+		q := query.NewBaseQuery()
+		q.WithPagination(2, 0)
+		q.WithOrderBy("-created_at")
+
+		token, err := query.NextCursor(posts, q)
+		require.NoError(t, err)
+
+		cursor, err := query.DecodeCursor(token)
+		require.NoError(t, err)
+		assert.Equal(t, "created_at", cursor.Field)
+		assert.True(t, cursor.Desc)
+		assert.Equal(t, uint(2), cursor.ID)
+		require.NotNil(t, cursor.Value)
+		assert.Equal(t, "2024-01-02T03:04:05Z", *cursor.Value)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		q := query.NewBaseQuery()
+		q.WithPagination(3, 0)
+
+		token, err := query.NextCursor(posts, q)
+		require.NoError(t, err)
+		assert.Empty(t, token)
+	})
+
+	t.Run("null sort key", func(t *testing.T) {
+		q := query.NewBaseQuery()
+		q.WithPagination(2, 0)
+		q.WithOrderBy("published_at")
+
+		token, err := query.NextCursor(posts, q)
+		require.NoError(t, err)
+
+		cursor, err := query.DecodeCursor(token)
+		require.NoError(t, err)
+		assert.Nil(t, cursor.Value)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		q := query.NewBaseQuery()
+		q.WithPagination(2, 0)
+		q.WithOrderBy("missing")
+
+		_, err := query.NextCursor(posts, q)
+		require.ErrorIs(t, err, errors.ErrInvalidOrderByField)
+	})
+}
@@ -0,0 +1,63 @@
+package slug_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jambo0624/blog/internal/shared/domain/constants"
+	"github.com/jambo0624/blog/internal/shared/domain/slug"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		title    string
+		expected string
+	}{
+		{"Hello, World!", "hello-world"},
+		{"  Go 1.23 -- what's new?  ", "go-1-23-what-s-new"},
+		{"Café Crème Brûlée", "cafe-creme-brulee"},
+		{"Straße in Łódź", "strasse-in-lodz"},
+		{"Привет, мир", "privet-mir"},
+		{"объект", "obekt"},
+		{"Дальний Восток", "dalnii-vostok"},
+		{"Καλημέρα κόσμε", "kalimera-kosme"},
+		{"你好", slug.Fallback},
+		{"Rust 与 Go", "rust-go"},
+		{"", slug.Fallback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.expected, slug.Make(tt.title))
+		})
+	}
+}
+
+func TestMake_Truncates(t *testing.T) {
+	s := slug.Make(strings.Repeat("word ", 50))
+
+	assert.LessOrEqual(t, len(s), constants.MaxSlugLength)
+	assert.True(t, strings.HasSuffix(s, "word"))
+	assert.True(t, slug.Valid(s))
+}
+
+func TestWithSuffix(t *testing.T) {
+	assert.Equal(t, "hello-world-2", slug.WithSuffix("hello-world", 2))
+
+	long := slug.Make(strings.Repeat("word ", 50))
+	suffixed := slug.WithSuffix(long, 12)
+	assert.LessOrEqual(t, len(suffixed), constants.MaxSlugLength)
+	assert.True(t, strings.HasSuffix(suffixed, "-word-12"))
+}
+
+func TestValid(t *testing.T) {
+	assert.True(t, slug.Valid("hello-world-2"))
+	assert.False(t, slug.Valid("Hello-World"))
+	assert.False(t, slug.Valid("hello--world"))
+	assert.False(t, slug.Valid("-hello"))
+	assert.False(t, slug.Valid("héllo"))
+	assert.False(t, slug.Valid(""))
+	assert.False(t, slug.Valid(strings.Repeat("a", constants.MaxSlugLength+1)))
+}
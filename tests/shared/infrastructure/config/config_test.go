@@ -0,0 +1,40 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/shared/infrastructure/config"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/errors"
+)
+
+func TestLoadConfig_JWTSecret(t *testing.T) {
+	tests := []struct {
+		env     string
+		secret  string
+		wantErr error
+	}{
+		{env: "development"},
+		{env: "test"},
+		{env: "", wantErr: errors.ErrMissingJWTSecret},
+		{env: "staging", wantErr: errors.ErrMissingJWTSecret},
+		{env: "staging", secret: "staging-secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env+"/"+tt.secret, func(t *testing.T) {
+			t.Setenv("GO_ENV", tt.env)
+			t.Setenv("JWT_SECRET", tt.secret)
+
+			cfg, err := config.LoadConfig()
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, cfg.Auth.JWTSecret)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package migration_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/database/migrations"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/errors"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/migration"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"002_add_slug.up.sql":        {Data: []byte("ALTER TABLE probes ADD COLUMN slug TEXT;")},
+		"002_add_slug.down.sql":      {Data: []byte("ALTER TABLE probes DROP COLUMN slug;")},
+		"001_create_probes.up.sql":   {Data: []byte("CREATE TABLE probes (id SERIAL PRIMARY KEY);")},
+		"001_create_probes.down.sql": {Data: []byte("DROP TABLE probes;")},
+		"README.md":                  {Data: []byte("ignored")},
+	}
+
+	loaded, err := migration.Load(fsys)
+
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+	assert.Equal(t, uint64(1), loaded[0].Version)
+	assert.Equal(t, "create_probes", loaded[0].Name)
+	assert.Equal(t, "DROP TABLE probes;", loaded[0].Down)
+	assert.Equal(t, uint64(2), loaded[1].Version)
+	assert.Len(t, loaded[1].Checksum(), 64)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		want error
+	}{
+		{
+			name: "bad name",
+			fsys: fstest.MapFS{"create_probes.sql": {Data: []byte("")}},
+			want: errors.ErrInvalidMigrationName,
+		},
+		{
+			name: "missing down",
+			fsys: fstest.MapFS{"001_create_probes.up.sql": {Data: []byte("SELECT 1;")}},
+			want: errors.ErrIncompleteMigration,
+		},
+		{
+			name: "duplicate version",
+			fsys: fstest.MapFS{
+				"001_create_probes.up.sql": {Data: []byte("SELECT 1;")},
+				"001_create_others.up.sql": {Data: []byte("SELECT 2;")},
+			},
+			want: errors.ErrDuplicateMigration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := migration.Load(tt.fsys)
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestLoad_Embedded(t *testing.T) {
+	loaded, err := migration.Load(migrations.FS)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, loaded)
+	for i, m := range loaded {
+		assert.Equal(t, uint64(i+1), m.Version, "migrations must be numbered without gaps")
+	}
+}
+
+func TestSteps_Embedded(t *testing.T) {
+	loaded, err := migration.Load(migrations.FS)
+	require.NoError(t, err)
+
+	versions := make([]uint64, 0, len(loaded))
+	for _, m := range loaded {
+		versions = append(versions, m.Version)
+	}
+	for version := range migrations.Steps {
+		assert.Contains(t, versions, version, "step %d has no migration", version)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "007_create_probes.up.sql"), []byte("SELECT 1;"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "007_create_probes.down.sql"), []byte("SELECT 1;"), 0o600))
+
+	up, down, err := migration.Create(dir, "add_slug")
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "008_add_slug.up.sql"), up)
+	assert.Equal(t, filepath.Join(dir, "008_add_slug.down.sql"), down)
+
+	loaded, err := migration.Load(os.DirFS(dir))
+	require.NoError(t, err)
+	assert.Len(t, loaded, 2)
+
+	_, _, err = migration.Create(dir, "Add Slug")
+	require.ErrorIs(t, err, errors.ErrInvalidMigrationName)
+}
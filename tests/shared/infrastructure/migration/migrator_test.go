@@ -0,0 +1,174 @@
+package migration_test
+
+import (
+	"context"
+	stdErrors "errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/shared/infrastructure/errors"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/migration"
+	"github.com/jambo0624/blog/tests/testutil"
+)
+
+const probeTable = "schema_migrations_probe"
+
+func probeMigrations() fstest.MapFS {
+	return fstest.MapFS{
+		"001_create_probes.up.sql":   {Data: []byte("CREATE TABLE migration_probes (id SERIAL PRIMARY KEY);")},
+		"001_create_probes.down.sql": {Data: []byte("DROP TABLE migration_probes;")},
+		"002_add_name.up.sql":        {Data: []byte("ALTER TABLE migration_probes ADD COLUMN name TEXT;")},
+		"002_add_name.down.sql":      {Data: []byte("ALTER TABLE migration_probes DROP COLUMN name;")},
+	}
+}
+
+// dropProbes removes the probe tables once the test is done. The migrator
+// pins its own connection outside the test transaction, so nothing it does is
+// rolled back.
+func dropProbes(t *testing.T, testDB *testutil.TestDB) {
+	t.Helper()
+
+	sqlDB, err := testDB.DB.DB()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = sqlDB.Exec("DROP TABLE IF EXISTS migration_probes")
+		_, _ = sqlDB.Exec("DROP TABLE IF EXISTS " + probeTable)
+	})
+}
+
+func TestMigrator(t *testing.T) {
+	testDB, cleanup := testutil.SetupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	dropProbes(t, testDB)
+
+	migrator, err := migration.NewMigrator(testDB.DB, probeMigrations())
+	require.NoError(t, err)
+	migrator.WithTable(probeTable)
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Len(t, applied, 2)
+
+	applied, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+
+	reverted, err := migrator.Down(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, reverted, 1)
+	assert.Equal(t, uint64(2), reverted[0].Version)
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	assert.Equal(t, migration.StateApplied, statuses[0].State)
+	assert.Equal(t, migration.StatePending, statuses[1].State)
+}
+
+func TestMigrator_Steps(t *testing.T) {
+	testDB, cleanup := testutil.SetupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	dropProbes(t, testDB)
+
+	failed := stdErrors.New("backfill failed")
+	migrator, err := migration.NewMigrator(testDB.DB, probeMigrations())
+	require.NoError(t, err)
+	migrator.WithTable(probeTable).WithSteps(map[uint64]migration.Step{
+		1: func(tx *gorm.DB) error {
+			return tx.Exec("INSERT INTO migration_probes DEFAULT VALUES").Error
+		},
+		2: func(*gorm.DB) error { return failed },
+	})
+
+	applied, err := migrator.Up(ctx)
+	require.ErrorIs(t, err, failed)
+	require.Len(t, applied, 1)
+
+	var count int64
+	require.NoError(t, testDB.DB.Table("migration_probes").Count(&count).Error)
+	assert.Equal(t, int64(1), count, "the first step ran in its migration")
+	assert.False(t, testDB.DB.Migrator().HasColumn("migration_probes", "name"), "a failed step rolls its migration back")
+}
+
+func TestMigrator_ChecksumMismatch(t *testing.T) {
+	testDB, cleanup := testutil.SetupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	dropProbes(t, testDB)
+
+	migrator, err := migration.NewMigrator(testDB.DB, probeMigrations())
+	require.NoError(t, err)
+	_, err = migrator.WithTable(probeTable).Up(ctx)
+	require.NoError(t, err)
+
+	edited := probeMigrations()
+	edited["002_add_name.up.sql"] = &fstest.MapFile{Data: []byte("ALTER TABLE migration_probes ADD COLUMN title TEXT;")}
+	migrator, err = migration.NewMigrator(testDB.DB, edited)
+	require.NoError(t, err)
+	migrator.WithTable(probeTable)
+
+	_, err = migrator.Up(ctx)
+	require.ErrorIs(t, err, errors.ErrChecksumMismatch)
+	require.ErrorIs(t, migrator.Check(ctx), errors.ErrChecksumMismatch)
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, migration.StateModified, statuses[1].State)
+}
+
+func TestMigrator_Check(t *testing.T) {
+	testDB, cleanup := testutil.SetupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	dropProbes(t, testDB)
+
+	migrator, err := migration.NewMigrator(testDB.DB, probeMigrations())
+	require.NoError(t, err)
+	migrator.WithTable(probeTable)
+
+	require.ErrorIs(t, migrator.Check(ctx), errors.ErrPendingMigration)
+
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	require.NoError(t, migrator.Check(ctx))
+
+	_, err = migrator.Down(ctx, 1)
+	require.NoError(t, err)
+	require.ErrorIs(t, migrator.Check(ctx), errors.ErrPendingMigration)
+}
+
+func TestMigrator_Check_DatabaseAhead(t *testing.T) {
+	testDB, cleanup := testutil.SetupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	dropProbes(t, testDB)
+
+	migrator, err := migration.NewMigrator(testDB.DB, probeMigrations())
+	require.NoError(t, err)
+	_, err = migrator.WithTable(probeTable).Up(ctx)
+	require.NoError(t, err)
+
+	// The previous release only knows the first migration.
+	previous := probeMigrations()
+	delete(previous, "002_add_name.up.sql")
+	delete(previous, "002_add_name.down.sql")
+	migrator, err = migration.NewMigrator(testDB.DB, previous)
+	require.NoError(t, err)
+	migrator.WithTable(probeTable)
+
+	require.NoError(t, migrator.Check(ctx), "a newer applied migration keeps the previous release ready")
+
+	_, err = migrator.Up(ctx)
+	require.ErrorIs(t, err, errors.ErrUnknownMigration, "migrating still refuses an unknown migration")
+}
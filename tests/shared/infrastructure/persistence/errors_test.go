@@ -0,0 +1,49 @@
+package persistence_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
+)
+
+func TestTranslateError_NotFound(t *testing.T) {
+	err := persistence.TranslateError(gorm.ErrRecordNotFound)
+
+	assert.ErrorIs(t, err, domainErrors.ErrNotFound)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound, "the original error stays in the chain")
+	assert.Equal(t, domainErrors.KindNotFound, domainErrors.KindOf(err))
+}
+
+func TestTranslateError_UniqueViolation(t *testing.T) {
+	pgErr := &pgconn.PgError{Code: "23505", ConstraintName: "idx_articles_slug"}
+
+	err := persistence.TranslateError(fmt.Errorf("insert: %w", pgErr))
+
+	assert.ErrorIs(t, err, domainErrors.ErrDuplicate)
+	assert.Equal(t, domainErrors.KindConflict, domainErrors.KindOf(err))
+}
+
+func TestTranslateError_ForeignKeyViolation(t *testing.T) {
+	pgErr := &pgconn.PgError{Code: "23503", ConstraintName: "articles_category_id_fkey"}
+
+	err := persistence.TranslateError(fmt.Errorf("delete: %w", pgErr))
+
+	assert.ErrorIs(t, err, domainErrors.ErrReferenced)
+	assert.ErrorIs(t, err, pgErr, "the original error stays in the chain")
+	assert.Equal(t, domainErrors.KindConflict, domainErrors.KindOf(err))
+}
+
+func TestTranslateError_Other(t *testing.T) {
+	pgErr := &pgconn.PgError{Code: "57P01"}
+
+	assert.NoError(t, persistence.TranslateError(nil))
+	assert.Same(t, pgErr, persistence.TranslateError(pgErr))
+	assert.Equal(t, domainErrors.KindInternal, domainErrors.KindOf(errors.New("connection reset")))
+}
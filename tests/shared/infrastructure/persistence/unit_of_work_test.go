@@ -0,0 +1,58 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/shared/infrastructure/persistence"
+	tagPersistence "github.com/jambo0624/blog/internal/tag/infrastructure/repository"
+	"github.com/jambo0624/blog/tests/testutil"
+	factory "github.com/jambo0624/blog/tests/testutil/factory"
+)
+
+var errAbort = errors.New("abort")
+
+func TestGormUnitOfWork_Commit(t *testing.T) {
+	testDB, cleanup := testutil.SetupTestDB(t)
+	defer cleanup()
+
+	uow := persistence.NewGormUnitOfWork(testDB.DB)
+	repo := tagPersistence.NewGormTagRepository(testDB.DB)
+	tag := factory.NewTagFactory().BuildEntity()
+
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		return repo.Save(ctx, tag)
+	})
+	require.NoError(t, err)
+
+	_, err = repo.FindByID(context.Background(), tag.ID)
+	require.NoError(t, err)
+}
+
+func TestGormUnitOfWork_Rollback(t *testing.T) {
+	testDB, cleanup := testutil.SetupTestDB(t)
+	defer cleanup()
+
+	uow := persistence.NewGormUnitOfWork(testDB.DB)
+	repo := tagPersistence.NewGormTagRepository(testDB.DB)
+	tag := factory.NewTagFactory().BuildEntity()
+
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		if err := repo.Save(ctx, tag); err != nil {
+			return err
+		}
+		// Nested units of work join the outer transaction.
+		return uow.Do(ctx, func(context.Context) error {
+			return errAbort
+		})
+	})
+	require.ErrorIs(t, err, errAbort)
+
+	_, err = repo.FindByID(context.Background(), tag.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
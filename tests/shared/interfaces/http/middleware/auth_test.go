@@ -0,0 +1,84 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+	"github.com/jambo0624/blog/tests/testutil"
+)
+
+func setupTest(t *testing.T, principal **auth.Principal) *testutil.HTTPTester {
+	t.Helper()
+
+	authenticator := testutil.NewFakeAuthenticator(7, auth.RoleAuthor)
+	handler := func(c *gin.Context) {
+		*principal, _ = middleware.PrincipalFrom(c)
+		response.Success(c, nil)
+	}
+
+	return testutil.NewHTTPTester(t, func(api *gin.RouterGroup) {
+		content := api.Group("/content", middleware.RequireAuthForWrites(authenticator))
+		content.GET("", handler)
+		content.POST("", handler)
+
+		private := api.Group("/private", middleware.RequireAuth(authenticator))
+		private.GET("", handler)
+	})
+}
+
+func TestRequireAuthForWrites(t *testing.T) {
+	var principal *auth.Principal
+	tester := setupTest(t, &principal)
+
+	tester.Get("/api/content", nil).SeeStatus(http.StatusOK)
+	assert.Nil(t, principal)
+
+	tester.Post("/api/content").SeeStatus(http.StatusUnauthorized)
+
+	tester.
+		WithHeader("Authorization", "Bearer "+testutil.TestToken).
+		Post("/api/content").
+		SeeStatus(http.StatusOK)
+	assert.Equal(t, &auth.Principal{UserID: 7, Role: auth.RoleAuthor, Method: auth.MethodJWT}, principal)
+}
+
+func TestRequireAuthForWrites_ReadWithCredentials(t *testing.T) {
+	var principal *auth.Principal
+	tester := setupTest(t, &principal)
+
+	tester.
+		WithHeader("Authorization", "Bearer "+testutil.TestToken).
+		Get("/api/content", nil).
+		SeeStatus(http.StatusOK)
+	assert.Equal(t, &auth.Principal{UserID: 7, Role: auth.RoleAuthor, Method: auth.MethodJWT}, principal)
+
+	tester.
+		WithHeader("Authorization", "Bearer wrong-token").
+		Get("/api/content", nil).
+		SeeStatus(http.StatusUnauthorized)
+}
+
+func TestRequireAuth(t *testing.T) {
+	var principal *auth.Principal
+	tester := setupTest(t, &principal)
+
+	tester.Get("/api/private", nil).SeeStatus(http.StatusUnauthorized)
+
+	tester.
+		WithHeader("Authorization", "Bearer wrong-token").
+		Get("/api/private", nil).
+		SeeStatus(http.StatusUnauthorized)
+
+	tester.
+		WithHeader("Authorization", "").
+		WithHeader("X-API-Key", testutil.TestAPIKey).
+		Get("/api/private", nil).
+		SeeStatus(http.StatusOK)
+	assert.Equal(t, &auth.Principal{UserID: 7, Role: auth.RoleAuthor, Method: auth.MethodAPIKey}, principal)
+}
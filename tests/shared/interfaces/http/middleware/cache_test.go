@@ -0,0 +1,43 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+	"github.com/jambo0624/blog/tests/testutil"
+)
+
+func TestCacheControl(t *testing.T) {
+	handler := func(c *gin.Context) {
+		response.Success(c, nil)
+	}
+	policies := map[string]string{"articles": "public, max-age=60"}
+
+	tester := testutil.NewHTTPTester(t, func(api *gin.RouterGroup) {
+		content := api.Group("", middleware.CacheControl("public, no-cache", policies))
+		content.GET("/articles/:id", handler)
+		content.POST("/articles", handler)
+		content.GET("/tags", handler)
+	})
+
+	tester.Get("/api/articles/1", nil).
+		SeeStatus(http.StatusOK).
+		SeeHeader("Cache-Control", "public, max-age=60")
+
+	tester.Get("/api/tags", nil).
+		SeeStatus(http.StatusOK).
+		SeeHeader("Cache-Control", "public, no-cache")
+
+	tester.Post("/api/articles").
+		SeeStatus(http.StatusOK).
+		SeeHeader("Cache-Control", "")
+
+	tester.WithHeader("Authorization", "Bearer "+testutil.TestToken).
+		Get("/api/articles/1", nil).
+		SeeStatus(http.StatusOK).
+		SeeHeader("Cache-Control", "private, no-cache")
+}
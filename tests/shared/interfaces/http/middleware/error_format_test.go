@@ -0,0 +1,108 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+	"github.com/jambo0624/blog/tests/testutil"
+)
+
+func setupErrorFormatTest(t *testing.T, format response.ErrorFormat) *testutil.HTTPTester {
+	t.Helper()
+
+	return testutil.NewHTTPTester(t, func(api *gin.RouterGroup) {
+		group := api.Group("", middleware.ErrorFormat(format))
+		group.GET("/missing", func(c *gin.Context) {
+			response.HandleError(c, domainErrors.ErrArticleNotFound)
+		})
+		group.POST("/duplicate", func(c *gin.Context) {
+			cause := errors.New(`ERROR: duplicate key value violates unique constraint "idx_tags_name" (SQLSTATE 23505)`)
+			response.HandleError(c, fmt.Errorf("failed to save tag: %w", errors.Join(domainErrors.ErrDuplicate, cause)))
+		})
+		group.POST("/invalid", func(c *gin.Context) {
+			var errs dto.ValidationErrors
+			errs.Add("title", "required", "is required")
+			response.ValidationError(c, errs)
+		})
+	})
+}
+
+func TestErrorFormat_EnvelopeByDefault(t *testing.T) {
+	tester := setupErrorFormatTest(t, response.ErrorFormat{Default: response.FormatEnvelope})
+
+	body := tester.
+		Get("/api/missing", nil).
+		SeeStatus(http.StatusNotFound).
+		SeeHeader("Content-Type", "application/json; charset=utf-8").
+		Body()
+
+	assert.JSONEq(t, `{"code":404001,"message":"resource not found","data":null,"meta":{}}`, body)
+}
+
+func TestErrorFormat_AcceptProblem(t *testing.T) {
+	tester := setupErrorFormatTest(t, response.ErrorFormat{
+		Default:     response.FormatEnvelope,
+		TypeBaseURL: "https://example.com/problems",
+	})
+
+	body := tester.
+		WithHeader("Accept", response.ProblemContentType).
+		Get("/api/missing", nil).
+		SeeStatus(http.StatusNotFound).
+		SeeHeader("Content-Type", response.ProblemContentType).
+		Body()
+
+	var problem response.Problem
+	require.NoError(t, json.Unmarshal([]byte(body), &problem))
+	assert.Equal(t, response.Problem{
+		Type:     "https://example.com/problems/404001",
+		Title:    "resource not found",
+		Status:   http.StatusNotFound,
+		Instance: "/api/missing",
+		Code:     response.CodeNotFound,
+	}, problem)
+}
+
+func TestErrorFormat_ProblemByConfig(t *testing.T) {
+	tester := setupErrorFormatTest(t, response.ErrorFormat{Default: response.FormatProblem})
+
+	body := tester.
+		Post("/api/invalid").
+		SeeStatus(http.StatusUnprocessableEntity).
+		SeeHeader("Content-Type", response.ProblemContentType).
+		Body()
+
+	var problem response.Problem
+	require.NoError(t, json.Unmarshal([]byte(body), &problem))
+	assert.Equal(t, "/problems/422001", problem.Type)
+	assert.Equal(t, dto.ValidationErrors{{Field: "title", Rule: "required", Message: "is required"}}, problem.Errors)
+
+	// a client that only takes the envelope still gets it
+	tester.
+		WithHeader("Accept", "application/json").
+		Post("/api/invalid").
+		SeeStatus(http.StatusUnprocessableEntity).
+		SeeHeader("Content-Type", "application/json; charset=utf-8")
+}
+
+func TestErrorFormat_HidesWrappedCauses(t *testing.T) {
+	tester := setupErrorFormatTest(t, response.ErrorFormat{Default: response.FormatEnvelope})
+
+	body := tester.
+		Post("/api/duplicate").
+		SeeStatus(http.StatusConflict).
+		Body()
+
+	assert.JSONEq(t, `{"code":409001,"message":"resource already exists","data":null,"meta":{}}`, body)
+}
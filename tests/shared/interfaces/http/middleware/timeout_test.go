@@ -0,0 +1,33 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
+	"github.com/jambo0624/blog/tests/testutil"
+)
+
+func TestTimeout(t *testing.T) {
+	var hasDeadline bool
+	handler := func(c *gin.Context) {
+		_, hasDeadline = c.Request.Context().Deadline()
+		response.Success(c, nil)
+	}
+
+	tester := testutil.NewHTTPTester(t, func(api *gin.RouterGroup) {
+		api.GET("/bounded", middleware.Timeout(time.Second), handler)
+		api.GET("/unbounded", middleware.Timeout(0), handler)
+	})
+
+	tester.Get("/api/bounded", nil).SeeStatus(http.StatusOK)
+	assert.True(t, hasDeadline)
+
+	tester.Get("/api/unbounded", nil).SeeStatus(http.StatusOK)
+	assert.False(t, hasDeadline)
+}
@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,7 +40,7 @@ func TestTagService_Create(t *testing.T) {
 		return t.Name == expectedTag.Name && t.Color == expectedTag.Color
 	})).Return(nil)
 
-	tag, err := service.Create(req)
+	tag, err := service.Create(context.Background(), req)
 	require.NoError(t, err)
 	assert.Equal(t, expectedTag.Name, tag.Name)
 	assert.Equal(t, expectedTag.Color, tag.Color)
@@ -56,7 +57,7 @@ func TestTagService_Update(t *testing.T) {
 		return t.ID == existingTag.ID && t.Name == req.Name && t.Color == req.Color
 	})).Return(nil)
 
-	tag, err := service.Update(existingTag.ID, req)
+	tag, err := service.Update(context.Background(), existingTag.ID, req)
 	require.NoError(t, err)
 	assert.Equal(t, req.Name, tag.Name)
 	assert.Equal(t, req.Color, tag.Color)
@@ -68,7 +69,7 @@ func TestTagService_FindByID(t *testing.T) {
 	expectedTag := factory.BuildEntity()
 	mockRepo.On("FindByID", expectedTag.ID, mock.Anything).Return(expectedTag, nil)
 
-	tag, err := service.FindByID(expectedTag.ID)
+	tag, err := service.FindByID(context.Background(), expectedTag.ID)
 	require.NoError(t, err)
 	assert.Equal(t, expectedTag.Name, tag.Name)
 	assert.Equal(t, expectedTag.Color, tag.Color)
@@ -81,7 +82,7 @@ func TestTagService_FindAll(t *testing.T) {
 	mockRepo.On("FindAll", mock.AnythingOfType("*query.TagQuery")).
 		Return(expectedTags, int64(len(expectedTags)), nil)
 
-	tags, total, err := service.FindAll(tagQuery.NewTagQuery())
+	tags, total, err := service.FindAll(context.Background(), tagQuery.NewTagQuery())
 	require.NoError(t, err)
 	assert.Equal(t, int64(len(expectedTags)), total)
 	assert.Len(t, tags, len(expectedTags))
@@ -93,7 +94,7 @@ func TestTagService_Create_ValidationError(t *testing.T) {
 	req := factory.BuildCreateRequest()
 	req.Name = "" // invalid name
 
-	tag, err := service.Create(req)
+	tag, err := service.Create(context.Background(), req)
 	require.Error(t, err)
 	assert.Nil(t, tag)
 	mockRepo.AssertNotCalled(t, "Save")
@@ -105,7 +106,7 @@ func TestTagService_Update_NotFound(t *testing.T) {
 	mockRepo.On("FindByID", uint(999), mock.Anything).Return(nil, gorm.ErrRecordNotFound)
 
 	req := factory.BuildUpdateRequest()
-	tag, err := service.Update(999, req)
+	tag, err := service.Update(context.Background(), 999, req)
 
 	require.Error(t, err)
 	assert.Nil(t, tag)
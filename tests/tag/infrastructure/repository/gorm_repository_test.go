@@ -1,11 +1,15 @@
 package repository_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	sharedQuery "github.com/jambo0624/blog/internal/shared/domain/query"
 	tagEntity "github.com/jambo0624/blog/internal/tag/domain/entity"
 	tagQuery "github.com/jambo0624/blog/internal/tag/domain/query"
 	tagRepository "github.com/jambo0624/blog/internal/tag/domain/repository"
@@ -30,7 +34,7 @@ func TestGormTagRepository_FindByID(t *testing.T) {
 
 	tag := testDB.Data.Tags[0]
 
-	found, err := repo.FindByID(tag.ID)
+	found, err := repo.FindByID(context.Background(), tag.ID)
 	require.NoError(t, err)
 	assert.Equal(t, tag.Name, found.Name)
 	assert.Equal(t, tag.Color, found.Color)
@@ -45,11 +49,39 @@ func TestGormTagRepository_FindAll(t *testing.T) {
 		name := testDB.Data.Tags[0].Name
 		q.WithNameLike(name)
 
-		tags, total, err := repo.FindAll(q)
+		tags, total, err := repo.FindAll(context.Background(), q)
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Contains(t, tags[0].Name, name)
 	})
+
+	t.Run("with cursor", func(t *testing.T) {
+		first := tagQuery.NewTagQuery()
+		first.WithPagination(1, 0)
+		first.WithOrderBy("name")
+
+		page, _, err := repo.FindAll(context.Background(), first)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+
+		token, err := sharedQuery.NextCursor(page, first.BaseQuery)
+		require.NoError(t, err)
+		cursor, err := sharedQuery.DecodeCursor(token)
+		require.NoError(t, err)
+
+		next := tagQuery.NewTagQuery()
+		next.WithPagination(1, 0)
+		next.WithOrderBy("name")
+		next.WithCursor(cursor)
+		next.WithoutCount()
+
+		tags, total, err := repo.FindAll(context.Background(), next)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		require.Len(t, tags, 1)
+		assert.NotEqual(t, page[0].ID, tags[0].ID)
+		assert.GreaterOrEqual(t, tags[0].Name, page[0].Name)
+	})
 }
 
 func TestGormTagRepository_Save(t *testing.T) {
@@ -58,11 +90,11 @@ func TestGormTagRepository_Save(t *testing.T) {
 
 	tag := factory.BuildEntity()
 
-	err := repo.Save(tag)
+	err := repo.Save(context.Background(), tag)
 	require.NoError(t, err)
 	assert.NotZero(t, tag.ID)
 
-	found, err := repo.FindByID(tag.ID)
+	found, err := repo.FindByID(context.Background(), tag.ID)
 	require.NoError(t, err)
 	assert.Equal(t, tag.Name, found.Name)
 	assert.Equal(t, tag.Color, found.Color)
@@ -75,30 +107,55 @@ func TestGormTagRepository_Update(t *testing.T) {
 	tag := testDB.Data.Tags[0]
 	tag.Name = "Updated Name"
 
-	err := repo.Update(tag)
+	err := repo.Update(context.Background(), tag)
 	require.NoError(t, err)
 
-	found, err := repo.FindByID(tag.ID)
+	found, err := repo.FindByID(context.Background(), tag.ID)
 	require.NoError(t, err)
 	assert.Equal(t, tag.Name, found.Name)
 }
 
+func TestGormTagRepository_Update_VersionConflict(t *testing.T) {
+	testDB, cleanup, repo, _ := setupTest(t)
+	defer cleanup()
+
+	tagID := testDB.Data.Tags[0].ID
+	first, err := repo.FindByID(context.Background(), tagID)
+	require.NoError(t, err)
+	second, err := repo.FindByID(context.Background(), tagID)
+	require.NoError(t, err)
+
+	first.Name = "First Writer"
+	require.NoError(t, repo.Update(context.Background(), first))
+	assert.Equal(t, second.Version+1, first.Version)
+
+	second.Name = "Second Writer"
+	err = repo.Update(context.Background(), second)
+	require.ErrorIs(t, err, errors.ErrVersionConflict)
+	assert.Equal(t, first.Version-1, second.Version)
+
+	found, err := repo.FindByID(context.Background(), tagID)
+	require.NoError(t, err)
+	assert.Equal(t, "First Writer", found.Name)
+	assert.Equal(t, first.Version, found.Version)
+}
+
 func TestGormTagRepository_Delete(t *testing.T) {
 	testDB, cleanup, repo, _ := setupTest(t)
 	defer cleanup()
 
 	tag := testDB.Data.Tags[0]
 
-	err := repo.Delete(tag.ID)
+	err := repo.Delete(context.Background(), tag.ID)
 	require.NoError(t, err)
 
 	var found tagEntity.Tag
 	err = testDB.DB.Unscoped().First(&found, tag.ID).Error
 	require.NoError(t, err)
-	assert.NotNil(t, found.DeletedAt)
+	assert.True(t, found.DeletedAt.Valid)
 
-	_, err = repo.FindByID(tag.ID)
-	require.NoError(t, err)
+	_, err = repo.FindByID(context.Background(), tag.ID)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
 }
 
 func TestGormTagRepository_FindAll_WithFilters(t *testing.T) {
@@ -134,10 +191,54 @@ func TestGormTagRepository_FindAll_WithFilters(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tags, count, err := repo.FindAll(tt.buildQuery())
+			tags, count, err := repo.FindAll(context.Background(), tt.buildQuery())
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedCount, count)
 			assert.Len(t, tags, int(tt.expectedCount))
 		})
 	}
 }
+
+func TestGormTagRepository_Restore(t *testing.T) {
+	testDB, cleanup, repo, _ := setupTest(t)
+	defer cleanup()
+
+	tag := testDB.Data.Tags[0]
+
+	err := repo.Restore(context.Background(), tag.ID)
+	require.ErrorIs(t, err, errors.ErrNotDeleted)
+
+	require.NoError(t, repo.Delete(context.Background(), tag.ID))
+
+	q := tagQuery.NewTagQuery()
+	q.OnlyDeleted()
+	trashed, _, err := repo.FindAll(context.Background(), q)
+	require.NoError(t, err)
+	require.Len(t, trashed, 1)
+	assert.Equal(t, tag.ID, trashed[0].ID)
+
+	require.NoError(t, repo.Restore(context.Background(), tag.ID))
+
+	found, err := repo.FindByID(context.Background(), tag.ID)
+	require.NoError(t, err)
+	assert.False(t, found.DeletedAt.Valid)
+}
+
+func TestGormTagRepository_Purge(t *testing.T) {
+	testDB, cleanup, repo, _ := setupTest(t)
+	defer cleanup()
+
+	tag := testDB.Data.Tags[0]
+
+	err := repo.Purge(context.Background(), tag.ID)
+	require.ErrorIs(t, err, errors.ErrNotDeleted)
+
+	require.NoError(t, repo.Delete(context.Background(), tag.ID))
+	require.NoError(t, repo.Purge(context.Background(), tag.ID))
+
+	var count int64
+	testDB.DB.Unscoped().Model(&tagEntity.Tag{}).Where("id = ?", tag.ID).Count(&count)
+	assert.Zero(t, count)
+	testDB.DB.Table("article_tags").Where("tag_id = ?", tag.ID).Count(&count)
+	assert.Zero(t, count)
+}
@@ -1,13 +1,24 @@
 package http_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	sharedQuery "github.com/jambo0624/blog/internal/shared/domain/query"
+	sharedDto "github.com/jambo0624/blog/internal/shared/interfaces/http/dto"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/response"
 	tagService "github.com/jambo0624/blog/internal/tag/application/service"
 	"github.com/jambo0624/blog/internal/tag/domain/entity"
+	"github.com/jambo0624/blog/internal/tag/domain/query"
 	tagHandler "github.com/jambo0624/blog/internal/tag/interfaces/http"
 	"github.com/jambo0624/blog/tests/testutil"
 	"github.com/jambo0624/blog/tests/testutil/factory"
@@ -16,13 +27,21 @@ import (
 
 func setupTest(t *testing.T) (*testutil.HTTPTester, *mockTag.MockTagRepository) {
 	t.Helper()
+	return setupTestAs(t, auth.RoleAdmin)
+}
+
+// setupTestAs authenticates every request as a user with the given role.
+func setupTestAs(t *testing.T, role auth.Role) (*testutil.HTTPTester, *mockTag.MockTagRepository) {
+	t.Helper()
 
 	mockRepo := new(mockTag.MockTagRepository)
 	service := tagService.NewTagService(mockRepo)
 	handler := tagHandler.NewTagHandler(service)
 	router := tagHandler.NewTagRouter(handler)
 
-	tester := testutil.NewHTTPTester(t, router.Register)
+	authenticator := testutil.NewFakeAuthenticator(1, role)
+	tester := testutil.NewHTTPTester(t, testutil.WithAuth(authenticator, router.Register)).
+		WithHeader("Authorization", "Bearer "+testutil.TestToken)
 
 	return tester, mockRepo
 }
@@ -47,6 +66,28 @@ func TestTagHandler_Create(t *testing.T) {
 		SeeStatus(http.StatusCreated)
 }
 
+func TestTagHandler_Create_InvalidFields(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	req := factory.NewTagFactory().BuildCreateRequest()
+	req.Name = "x"
+	req.Color = "blue"
+
+	body := tester.
+		WithJSONBody(req).
+		Post("/api/tags").
+		SeeStatus(http.StatusUnprocessableEntity).
+		Body()
+
+	var resp response.Response
+	require.NoError(t, json.Unmarshal([]byte(body), &resp))
+	require.Equal(t, response.CodeValidationFailed, resp.Code)
+	require.Equal(t, sharedDto.ValidationErrors{
+		{Field: "name", Rule: sharedDto.RuleMin, Message: "must be at least 2 characters"},
+		{Field: "color", Rule: sharedDto.RuleHexColor, Message: "must be a hex color"},
+	}, resp.Errors)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
 func TestTagHandler_GetByID(t *testing.T) {
 	tester, mockRepo := setupTest(t)
 	factory := factory.NewTagFactory()
@@ -72,6 +113,69 @@ func TestTagHandler_List(t *testing.T) {
 		SeeStatus(http.StatusOK)
 }
 
+func TestTagHandler_GetByID_NotModified(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewTagFactory()
+	tag := factory.BuildEntity()
+	tag.Version = 2
+	tag.UpdatedAt = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	path := "/api/tags/" + strconv.Itoa(int(tag.ID))
+
+	mockRepo.On("FindByID", tag.ID, mock.Anything).Return(tag, nil)
+
+	etag := tester.
+		Get(path, nil).
+		SeeStatus(http.StatusOK).
+		SeeHeader("Last-Modified", "Wed, 01 May 2024 12:00:00 GMT").
+		ResponseHeader("ETag")
+
+	tester.
+		WithHeader("If-None-Match", `"1", `+etag).
+		Get(path, nil).
+		SeeStatus(http.StatusNotModified)
+
+	tester.
+		WithHeader("If-None-Match", `W/"2"`).
+		Get(path, nil).
+		SeeStatus(http.StatusOK)
+
+	tester.
+		WithHeader("If-None-Match", "").
+		WithHeader("If-Modified-Since", "Wed, 01 May 2024 12:00:00 GMT").
+		Get(path, nil).
+		SeeStatus(http.StatusNotModified)
+
+	tester.
+		WithHeader("If-Modified-Since", "Wed, 01 May 2024 11:59:59 GMT").
+		Get(path, nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestTagHandler_List_NotModified(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewTagFactory()
+	tags := factory.BuildList(2)
+
+	mockRepo.On("FindAll", mock.AnythingOfType("*query.TagQuery")).
+		Return(tags, int64(len(tags)), nil)
+
+	etag := tester.
+		Get("/api/tags", nil).
+		SeeStatus(http.StatusOK).
+		ResponseHeader("ETag")
+	require.NotEmpty(t, etag)
+
+	tester.
+		WithHeader("If-None-Match", etag).
+		Get("/api/tags", nil).
+		SeeStatus(http.StatusNotModified)
+
+	tags[1].Version++
+	tester.
+		Get("/api/tags", nil).
+		SeeStatus(http.StatusOK)
+}
+
 func TestTagHandler_Update(t *testing.T) {
 	tester, mockRepo := setupTest(t)
 	factory := factory.NewTagFactory()
@@ -84,12 +188,134 @@ func TestTagHandler_Update(t *testing.T) {
 		return t.ID == existingTag.ID && t.Name == req.Name && t.Color == req.Color
 	})).Return(nil)
 
+	// the expected version can travel in the body instead of If-Match
+	req.IfMatch = &existingTag.Version
+
 	tester.
 		WithJSONBody(req).
 		Put("/api/tags/3").
 		SeeStatus(http.StatusOK)
 }
 
+func TestTagHandler_Update_PreconditionRequired(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewTagFactory()
+
+	existingTag := factory.BuildEntity()
+	req := factory.BuildUpdateRequest()
+	path := "/api/tags/" + strconv.Itoa(int(existingTag.ID))
+
+	tester.
+		WithJSONBody(req).
+		Put(path).
+		SeeStatus(http.StatusPreconditionRequired)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+
+	// If-Match: * updates whatever version is current
+	mockRepo.On("FindByID", existingTag.ID, mock.Anything).Return(existingTag, nil)
+	mockRepo.On("Update", mock.AnythingOfType("*entity.Tag")).Return(nil)
+
+	tester.
+		WithHeader("If-Match", "*").
+		WithJSONBody(req).
+		Put(path).
+		SeeStatus(http.StatusOK)
+}
+
+func TestTagHandler_GetByID_ETag(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewTagFactory()
+	tag := factory.BuildEntity()
+	tag.Version = 4
+
+	path := "/api/tags/" + strconv.Itoa(int(tag.ID))
+
+	mockRepo.On("FindByID", tag.ID, mock.Anything).Return(tag, nil)
+
+	etag := tester.
+		Get(path, nil).
+		SeeStatus(http.StatusOK).
+		ResponseHeader("ETag")
+	require.Regexp(t, `^W/"4-[0-9a-f]{32}"$`, etag)
+
+	// the representation changes without a write to the tag itself
+	tag.Name = "Renamed"
+	renamed := tester.
+		Get(path, nil).
+		SeeStatus(http.StatusOK).
+		ResponseHeader("ETag")
+	require.NotEqual(t, etag, renamed)
+}
+
+func TestTagHandler_Update_IfMatch(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewTagFactory()
+
+	existingTag := factory.BuildEntity()
+	existingTag.Version = 2
+	req := factory.BuildUpdateRequest()
+
+	mockRepo.On("FindByID", existingTag.ID, mock.Anything).Return(existingTag, nil)
+	mockRepo.On("Update", mock.AnythingOfType("*entity.Tag")).Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.Tag).Version++
+	}).Return(nil)
+
+	tester.
+		WithHeader("If-Match", `W/"2-0123456789abcdef"`).
+		WithJSONBody(req).
+		Put("/api/tags/" + strconv.Itoa(int(existingTag.ID))).
+		SeeStatus(http.StatusOK)
+
+	require.Regexp(t, `^W/"3-`, tester.ResponseHeader("ETag"), "the ETag carries the new version")
+}
+
+func TestTagHandler_Update_StaleIfMatch(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewTagFactory()
+
+	existingTag := factory.BuildEntity()
+	existingTag.Version = 3
+	req := factory.BuildUpdateRequest()
+
+	mockRepo.On("FindByID", existingTag.ID, mock.Anything).Return(existingTag, nil)
+
+	tester.
+		WithHeader("If-Match", `"2"`).
+		WithJSONBody(req).
+		Put("/api/tags/" + strconv.Itoa(int(existingTag.ID))).
+		SeeStatus(http.StatusPreconditionFailed)
+
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestTagHandler_Update_MalformedIfMatch(t *testing.T) {
+	tester, _ := setupTest(t)
+	req := factory.NewTagFactory().BuildUpdateRequest()
+
+	tester.
+		WithHeader("If-Match", `"two"`).
+		WithJSONBody(req).
+		Put("/api/tags/1").
+		SeeStatus(http.StatusPreconditionFailed)
+}
+
+func TestTagHandler_Update_ConcurrentWrite(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewTagFactory()
+
+	existingTag := factory.BuildEntity()
+	req := factory.BuildUpdateRequest()
+
+	mockRepo.On("FindByID", existingTag.ID, mock.Anything).Return(existingTag, nil)
+	mockRepo.On("Update", mock.AnythingOfType("*entity.Tag")).Return(errors.ErrVersionConflict)
+
+	tester.
+		WithHeader("If-Match", fmt.Sprintf(`"%d"`, existingTag.Version)).
+		WithJSONBody(req).
+		Put("/api/tags/" + strconv.Itoa(int(existingTag.ID))).
+		SeeStatus(http.StatusPreconditionFailed)
+}
+
 func TestTagHandler_Delete(t *testing.T) {
 	tester, mockRepo := setupTest(t)
 
@@ -99,3 +325,149 @@ func TestTagHandler_Delete(t *testing.T) {
 		Delete("/api/tags/1").
 		SeeStatus(http.StatusNoContent)
 }
+
+func TestTagHandler_List_WithCursor(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewTagFactory()
+	tags := factory.BuildList(2)
+
+	value := "golang"
+	cursor, err := (&sharedQuery.Cursor{Field: "name", Value: &value, ID: 7}).Encode()
+	require.NoError(t, err)
+
+	mockRepo.On("FindAll", mock.MatchedBy(func(q *query.TagQuery) bool {
+		return q.Cursor != nil && q.Cursor.ID == 7 && q.Offset == 0 && q.SkipCount
+	})).Return(tags, int64(0), nil)
+
+	tester.
+		Get("/api/tags", map[string]string{
+			"order_by": "name",
+			"cursor":   cursor,
+			"offset":   "20",
+			"count":    "false",
+		}).
+		SeeStatus(http.StatusOK)
+}
+
+func TestTagHandler_List_InvalidCursor(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	tester.
+		Get("/api/tags", map[string]string{"cursor": "not-a-cursor"}).
+		SeeStatus(http.StatusBadRequest)
+
+	mockRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+}
+
+func TestTagHandler_List_CursorSortMismatch(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	cursor, err := (&sharedQuery.Cursor{Field: "id", ID: 7}).Encode()
+	require.NoError(t, err)
+
+	tester.
+		Get("/api/tags", map[string]string{
+			"order_by": "name",
+			"cursor":   cursor,
+		}).
+		SeeStatus(http.StatusBadRequest)
+
+	mockRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+}
+
+func TestTagHandler_Delete_Author(t *testing.T) {
+	tester, mockRepo := setupTestAs(t, auth.RoleAuthor)
+
+	tester.
+		Delete("/api/tags/1").
+		SeeStatus(http.StatusForbidden)
+
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestTagHandler_Restore(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	tag := factory.NewTagFactory().BuildEntity()
+
+	mockRepo.On("Restore", tag.ID).Return(nil)
+	mockRepo.On("FindByID", tag.ID, mock.Anything).Return(tag, nil)
+
+	tester.
+		Post("/api/tags/" + strconv.FormatUint(uint64(tag.ID), 10) + "/restore").
+		SeeStatus(http.StatusOK)
+}
+
+func TestTagHandler_Restore_NotInTrash(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	mockRepo.On("Restore", uint(1)).Return(errors.ErrNotDeleted)
+
+	tester.
+		Post("/api/tags/1/restore").
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestTagHandler_Restore_PurgedMeanwhile(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	mockRepo.On("Restore", uint(1)).Return(nil)
+	mockRepo.On("FindByID", uint(1), mock.Anything).Return(nil, errors.ErrNotFound)
+
+	tester.
+		Post("/api/tags/1/restore").
+		SeeStatus(http.StatusNotFound)
+}
+
+func TestTagHandler_Purge(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	mockRepo.On("Purge", uint(1)).Return(nil)
+
+	tester.
+		Delete("/api/tags/1/purge").
+		SeeStatus(http.StatusNoContent)
+}
+
+func TestTagHandler_Purge_Referenced(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	mockRepo.On("Purge", uint(1)).Return(fmt.Errorf("%w: foreign key violation", errors.ErrReferenced))
+
+	tester.
+		Delete("/api/tags/1/purge").
+		SeeStatus(http.StatusConflict)
+}
+
+func TestTagHandler_Purge_Editor(t *testing.T) {
+	tester, mockRepo := setupTestAs(t, auth.RoleEditor)
+
+	tester.
+		Delete("/api/tags/1/purge").
+		SeeStatus(http.StatusForbidden)
+
+	mockRepo.AssertNotCalled(t, "Purge", mock.Anything)
+}
+
+func TestTagHandler_List_OnlyDeleted(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	tags := factory.NewTagFactory().BuildList(1)
+
+	mockRepo.On("FindAll", mock.MatchedBy(func(q *query.TagQuery) bool {
+		return q.Deleted == sharedQuery.DeletedOnly
+	})).Return(tags, int64(len(tags)), nil)
+
+	tester.
+		Get("/api/tags", map[string]string{"only_deleted": "true"}).
+		SeeStatus(http.StatusOK)
+}
+
+func TestTagHandler_List_OnlyDeleted_Anonymous(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	tester.
+		WithHeader("Authorization", "").
+		Get("/api/tags", map[string]string{"only_deleted": "true"}).
+		SeeStatus(http.StatusUnauthorized)
+
+	mockRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+}
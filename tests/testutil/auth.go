@@ -0,0 +1,51 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/shared/interfaces/http/middleware"
+)
+
+// Credentials accepted by FakeAuthenticator.
+const (
+	TestToken  = "test-token"
+	TestAPIKey = "test-api-key"
+)
+
+// FakeAuthenticator accepts TestToken and TestAPIKey for a fixed user.
+type FakeAuthenticator struct {
+	UserID uint
+	Role   auth.Role
+}
+
+func NewFakeAuthenticator(userID uint, role auth.Role) *FakeAuthenticator {
+	return &FakeAuthenticator{UserID: userID, Role: role}
+}
+
+func (a *FakeAuthenticator) AuthenticateToken(_ context.Context, token string) (*auth.Principal, error) {
+	if token != TestToken {
+		return nil, errors.ErrInvalidToken
+	}
+	return &auth.Principal{UserID: a.UserID, Role: a.Role, Method: auth.MethodJWT}, nil
+}
+
+func (a *FakeAuthenticator) AuthenticateAPIKey(_ context.Context, key string) (*auth.Principal, error) {
+	if key != TestAPIKey {
+		return nil, errors.ErrInvalidToken
+	}
+	return &auth.Principal{UserID: a.UserID, Role: a.Role, Method: auth.MethodAPIKey}, nil
+}
+
+// WithAuth wraps register so the routes sit behind RequireAuthForWrites, as
+// they do in the application router.
+func WithAuth(a middleware.Authenticator, register func(api *gin.RouterGroup)) func(api *gin.RouterGroup) {
+	return func(api *gin.RouterGroup) {
+		group := api.Group("")
+		group.Use(middleware.RequireAuthForWrites(a))
+		register(group)
+	}
+}
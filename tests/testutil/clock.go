@@ -0,0 +1,22 @@
+package testutil
+
+import "time"
+
+// FakeClock is a controllable clock for tests.
+type FakeClock struct {
+	now time.Time
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the fake current time.
+func (c *FakeClock) Now() time.Time {
+	return c.now
+}
+
+// Advance moves the clock forward by d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
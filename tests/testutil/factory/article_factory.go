@@ -4,6 +4,7 @@ import (
 	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
 	"github.com/jambo0624/blog/internal/article/interfaces/http/dto"
 	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
+	"github.com/jambo0624/blog/internal/shared/domain/slug"
 	tagEntity "github.com/jambo0624/blog/internal/tag/domain/entity"
 )
 
@@ -51,10 +52,12 @@ func (f *ArticleFactory) BuildEntity(opts ...func(*articleEntity.Article)) (
 		tags[i] = *t
 	}
 
+	title := f.FormatTestName("Article")
 	article := &articleEntity.Article{
 		ID:         seq,
 		CategoryID: category.ID,
-		Title:      f.FormatTestName("Article"),
+		Title:      title,
+		Slug:       slug.Make(title),
 		Content:    f.FormatTestName("Content"),
 		Tags:       tags,
 	}
@@ -141,3 +144,15 @@ func (f *ArticleFactory) WithContent(content string) func(*articleEntity.Article
 		a.Content = content
 	}
 }
+
+func (f *ArticleFactory) WithAuthorID(authorID uint) func(*articleEntity.Article) {
+	return func(a *articleEntity.Article) {
+		a.AuthorID = &authorID
+	}
+}
+
+func (f *ArticleFactory) WithStatus(status articleEntity.ArticleStatus) func(*articleEntity.Article) {
+	return func(a *articleEntity.Article) {
+		a.Status = status
+	}
+}
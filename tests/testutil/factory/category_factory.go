@@ -74,3 +74,10 @@ func (f *CategoryFactory) WithSlug(slug string) func(*categoryEntity.Category) {
 		c.Slug = slug
 	}
 }
+
+// WithParentID sets the parent category.
+func (f *CategoryFactory) WithParentID(parentID uint) func(*categoryEntity.Category) {
+	return func(c *categoryEntity.Category) {
+		c.ParentID = &parentID
+	}
+}
@@ -0,0 +1,80 @@
+package factory
+
+import (
+	commentEntity "github.com/jambo0624/blog/internal/comment/domain/entity"
+	"github.com/jambo0624/blog/internal/comment/interfaces/http/dto"
+)
+
+type CommentFactory struct {
+	BaseFactory
+}
+
+func NewCommentFactory() *CommentFactory {
+	return &CommentFactory{
+		BaseFactory: NewBaseFactory(),
+	}
+}
+
+// BuildEntity builds an approved top-level comment by user 1 on article 1.
+func (f *CommentFactory) BuildEntity(opts ...func(*commentEntity.Comment)) *commentEntity.Comment {
+	seq := f.NextSequence()
+	entity := &commentEntity.Comment{
+		ID:        seq,
+		ArticleID: 1,
+		UserID:    1,
+		Content:   f.FormatTestName("Comment"),
+		Status:    commentEntity.StatusApproved,
+	}
+	return ApplyOptions(entity, opts)
+}
+
+func (f *CommentFactory) buildRequest(isUpdate bool) interface{} {
+	if isUpdate {
+		return &dto.UpdateCommentRequest{Content: f.FormatUpdatedName("Comment")}
+	}
+	return &dto.CreateCommentRequest{Content: f.FormatTestName("Comment")}
+}
+
+func (f *CommentFactory) BuildCreateRequest(opts ...func(*dto.CreateCommentRequest)) *dto.CreateCommentRequest {
+	req := BuildRequest[*dto.CreateCommentRequest](false, f.buildRequest)
+	return ApplyOptions(req, opts)
+}
+
+func (f *CommentFactory) BuildUpdateRequest(opts ...func(*dto.UpdateCommentRequest)) *dto.UpdateCommentRequest {
+	req := BuildRequest[*dto.UpdateCommentRequest](true, f.buildRequest)
+	return ApplyOptions(req, opts)
+}
+
+func (f *CommentFactory) WithArticleID(articleID uint) func(*commentEntity.Comment) {
+	return func(c *commentEntity.Comment) {
+		c.ArticleID = articleID
+	}
+}
+
+func (f *CommentFactory) WithParent(parent *commentEntity.Comment) func(*commentEntity.Comment) {
+	return func(c *commentEntity.Comment) {
+		c.ArticleID = parent.ArticleID
+		c.ParentID = &parent.ID
+	}
+}
+
+func (f *CommentFactory) WithUserID(userID uint) func(*commentEntity.Comment) {
+	return func(c *commentEntity.Comment) {
+		c.UserID = userID
+	}
+}
+
+func (f *CommentFactory) WithStatus(status commentEntity.CommentStatus) func(*commentEntity.Comment) {
+	return func(c *commentEntity.Comment) {
+		c.Status = status
+	}
+}
+
+// BuildList creates a list of Comment entities.
+func (f *CommentFactory) BuildList(count int) []*commentEntity.Comment {
+	comments := make([]*commentEntity.Comment, count)
+	for i := range comments {
+		comments[i] = f.BuildEntity()
+	}
+	return comments
+}
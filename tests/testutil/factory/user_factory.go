@@ -0,0 +1,75 @@
+package factory
+
+import (
+	"fmt"
+
+	userEntity "github.com/jambo0624/blog/internal/user/domain/entity"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+)
+
+// TestPassword is the password set on users built by UserFactory.
+const TestPassword = "correct-horse-battery"
+
+type UserFactory struct {
+	BaseFactory
+}
+
+func NewUserFactory() *UserFactory {
+	return &UserFactory{
+		BaseFactory: NewBaseFactory(),
+	}
+}
+
+// BuildEntity creates a User entity whose password is TestPassword.
+func (f *UserFactory) BuildEntity(opts ...func(*userEntity.User)) *userEntity.User {
+	seq := f.NextSequence()
+	entity, err := userEntity.NewUser(f.formatEmail(), f.FormatTestName("User"), TestPassword)
+	if err != nil {
+		panic(err)
+	}
+	entity.ID = seq
+	return ApplyOptions(entity, opts)
+}
+
+func (f *UserFactory) buildRequest(isUpdate bool) interface{} {
+	if isUpdate {
+		return &dto.UpdateUserRequest{Name: f.FormatUpdatedName("User")}
+	}
+	return &dto.CreateUserRequest{
+		Email:    f.formatEmail(),
+		Name:     f.FormatTestName("User"),
+		Password: TestPassword,
+	}
+}
+
+// BuildCreateRequest creates a CreateUserRequest.
+func (f *UserFactory) BuildCreateRequest(opts ...func(*dto.CreateUserRequest)) *dto.CreateUserRequest {
+	req := BuildRequest[*dto.CreateUserRequest](false, f.buildRequest)
+	return ApplyOptions(req, opts)
+}
+
+// BuildUpdateRequest creates an UpdateUserRequest.
+func (f *UserFactory) BuildUpdateRequest(opts ...func(*dto.UpdateUserRequest)) *dto.UpdateUserRequest {
+	req := BuildRequest[*dto.UpdateUserRequest](true, f.buildRequest)
+	return ApplyOptions(req, opts)
+}
+
+// BuildList creates a list of User entities.
+func (f *UserFactory) BuildList(count int) []*userEntity.User {
+	users := make([]*userEntity.User, count)
+	for i := range users {
+		users[i] = f.BuildEntity()
+	}
+	return users
+}
+
+// WithEmail sets custom email.
+func (f *UserFactory) WithEmail(email string) func(*userEntity.User) {
+	return func(u *userEntity.User) {
+		u.Email = email
+	}
+}
+
+func (f *UserFactory) formatEmail() string {
+	return fmt.Sprintf("user%d@example.com", f.sequence)
+}
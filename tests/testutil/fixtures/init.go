@@ -46,11 +46,13 @@ func LoadFixtures(db *gorm.DB) (*TestData, error) {
 		{
 			CategoryID: categories[0].ID,
 			Title:      "Test Article 1",
+			Slug:       "test-article-1",
 			Content:    "Content 1",
 		},
 		{
 			CategoryID: categories[1].ID,
 			Title:      "Test Article 2",
+			Slug:       "test-article-2",
 			Content:    "Content 2",
 		},
 	}
@@ -127,6 +127,25 @@ func (a *HTTPTester) SeeStatus(expectedStatus int) *HTTPTester {
 	return a
 }
 
+// SeeHeader asserts the value of a response header.
+func (a *HTTPTester) SeeHeader(key, expected string) *HTTPTester {
+	if actual := a.response.Header().Get(key); actual != expected {
+		a.t.Fatalf("Expected header %s to be %q, but got %q", key, expected, actual)
+	}
+
+	return a
+}
+
+// ResponseHeader returns a header of the last response.
+func (a *HTTPTester) ResponseHeader(key string) string {
+	return a.response.Header().Get(key)
+}
+
+// Body returns the body of the last response.
+func (a *HTTPTester) Body() string {
+	return a.response.Body.String()
+}
+
 // DumpResponse logs the response body to the test output.
 func (a *HTTPTester) DumpResponse() *HTTPTester {
 	a.t.Logf("Response status: %d", a.response.Code)
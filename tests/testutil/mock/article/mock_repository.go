@@ -1,6 +1,8 @@
 package article
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 
 	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
@@ -17,12 +19,12 @@ const (
 	errorIndex   = 2
 )
 
-func (m *MockArticleRepository) Save(article *articleEntity.Article) error {
+func (m *MockArticleRepository) Save(_ context.Context, article *articleEntity.Article) error {
 	args := m.Called(article)
 	return args.Error(0)
 }
 
-func (m *MockArticleRepository) FindByID(id uint, preloads ...string) (*articleEntity.Article, error) {
+func (m *MockArticleRepository) FindByID(_ context.Context, id uint, preloads ...string) (*articleEntity.Article, error) {
 	args := m.Called(id, preloads)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -30,19 +32,82 @@ func (m *MockArticleRepository) FindByID(id uint, preloads ...string) (*articleE
 	return args.Get(0).(*articleEntity.Article), args.Error(1)
 }
 
-func (m *MockArticleRepository) FindAll(query *articleQuery.ArticleQuery) ([]*articleEntity.Article, int64, error) {
+func (m *MockArticleRepository) FindAll(_ context.Context, query *articleQuery.ArticleQuery) ([]*articleEntity.Article, int64, error) {
 	args := m.Called(query)
 	return args.Get(resultsIndex).([]*articleEntity.Article),
 		args.Get(countIndex).(int64),
 		args.Error(errorIndex)
 }
 
-func (m *MockArticleRepository) Update(article *articleEntity.Article) error {
+func (m *MockArticleRepository) Update(_ context.Context, article *articleEntity.Article) error {
 	args := m.Called(article)
 	return args.Error(0)
 }
 
-func (m *MockArticleRepository) Delete(id uint) error {
+func (m *MockArticleRepository) Delete(_ context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockArticleRepository) Restore(_ context.Context, id uint) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
+
+func (m *MockArticleRepository) Purge(_ context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockArticleRepository) Search(_ context.Context,
+	query *articleQuery.ArticleQuery,
+) ([]*articleEntity.ArticleSearchResult, int64, error) {
+	args := m.Called(query)
+	return args.Get(resultsIndex).([]*articleEntity.ArticleSearchResult),
+		args.Get(countIndex).(int64),
+		args.Error(errorIndex)
+}
+
+func (m *MockArticleRepository) UpdateCommentCount(_ context.Context, id uint, count int64) error {
+	args := m.Called(id, count)
+	return args.Error(0)
+}
+
+func (m *MockArticleRepository) FindBySlug(_ context.Context, slug string, preloads ...string) (*articleEntity.Article, error) {
+	args := m.Called(slug, preloads)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*articleEntity.Article), args.Error(1)
+}
+
+func (m *MockArticleRepository) FindByPreviousSlug(_ context.Context, slug string) (*articleEntity.Article, error) {
+	args := m.Called(slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*articleEntity.Article), args.Error(1)
+}
+
+func (m *MockArticleRepository) SlugTaken(_ context.Context, slug string, exceptID uint) (bool, error) {
+	args := m.Called(slug, exceptID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockArticleRepository) RecordSlugChange(_ context.Context, articleID uint, previous, current string) error {
+	args := m.Called(articleID, previous, current)
+	return args.Error(0)
+}
+
+func (m *MockArticleRepository) FindPublishedLinks(_ context.Context, limit, offset int) ([]*articleEntity.ArticleLink, error) {
+	args := m.Called(limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*articleEntity.ArticleLink), args.Error(1)
+}
+
+func (m *MockArticleRepository) CountPublished(_ context.Context) (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
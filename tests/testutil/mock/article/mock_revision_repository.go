@@ -0,0 +1,39 @@
+package article
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+)
+
+type MockArticleRevisionRepository struct {
+	mock.Mock
+}
+
+func (m *MockArticleRevisionRepository) Save(_ context.Context, revision *articleEntity.ArticleRevision) error {
+	args := m.Called(revision)
+	return args.Error(0)
+}
+
+func (m *MockArticleRevisionRepository) FindByArticleID(_ context.Context, articleID uint) ([]*articleEntity.ArticleRevision, error) {
+	args := m.Called(articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*articleEntity.ArticleRevision), args.Error(1)
+}
+
+func (m *MockArticleRevisionRepository) FindByNumber(_ context.Context, articleID, number uint) (*articleEntity.ArticleRevision, error) {
+	args := m.Called(articleID, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*articleEntity.ArticleRevision), args.Error(1)
+}
+
+func (m *MockArticleRevisionRepository) LatestNumber(_ context.Context, articleID uint) (uint, error) {
+	args := m.Called(articleID)
+	return args.Get(0).(uint), args.Error(1)
+}
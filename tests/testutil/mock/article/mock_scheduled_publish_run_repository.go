@@ -0,0 +1,18 @@
+package article
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	articleEntity "github.com/jambo0624/blog/internal/article/domain/entity"
+)
+
+type MockScheduledPublishRunRepository struct {
+	mock.Mock
+}
+
+func (m *MockScheduledPublishRunRepository) Save(_ context.Context, run *articleEntity.ScheduledPublishRun) error {
+	args := m.Called(run)
+	return args.Error(0)
+}
@@ -1,6 +1,8 @@
 package category
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 
 	categoryEntity "github.com/jambo0624/blog/internal/category/domain/entity"
@@ -11,13 +13,13 @@ type MockCategoryRepository struct {
 	mock.Mock
 }
 
-func (m *MockCategoryRepository) Save(category *categoryEntity.Category) error {
+func (m *MockCategoryRepository) Save(_ context.Context, category *categoryEntity.Category) error {
 	args := m.Called(category)
 	errorIndex := 0
 	return args.Error(errorIndex)
 }
 
-func (m *MockCategoryRepository) FindByID(id uint, preloads ...string) (*categoryEntity.Category, error) {
+func (m *MockCategoryRepository) FindByID(_ context.Context, id uint, preloads ...string) (*categoryEntity.Category, error) {
 	args := m.Called(id, preloads)
 	resultsIndex := 0
 	errorIndex := 1
@@ -27,7 +29,7 @@ func (m *MockCategoryRepository) FindByID(id uint, preloads ...string) (*categor
 	return args.Get(resultsIndex).(*categoryEntity.Category), args.Error(errorIndex)
 }
 
-func (m *MockCategoryRepository) FindAll(query *categoryQuery.CategoryQuery) (
+func (m *MockCategoryRepository) FindAll(_ context.Context, query *categoryQuery.CategoryQuery) (
 	[]*categoryEntity.Category, int64, error,
 ) {
 	args := m.Called(query)
@@ -37,13 +39,25 @@ func (m *MockCategoryRepository) FindAll(query *categoryQuery.CategoryQuery) (
 	return args.Get(resultsIndex).([]*categoryEntity.Category), args.Get(countIndex).(int64), args.Error(errorIndex)
 }
 
-func (m *MockCategoryRepository) Update(category *categoryEntity.Category) error {
+func (m *MockCategoryRepository) Update(_ context.Context, category *categoryEntity.Category) error {
 	args := m.Called(category)
 	errorIndex := 0
 	return args.Error(errorIndex)
 }
 
-func (m *MockCategoryRepository) Delete(id uint) error {
+func (m *MockCategoryRepository) Delete(_ context.Context, id uint) error {
+	args := m.Called(id)
+	errorIndex := 0
+	return args.Error(errorIndex)
+}
+
+func (m *MockCategoryRepository) Restore(_ context.Context, id uint) error {
+	args := m.Called(id)
+	errorIndex := 0
+	return args.Error(errorIndex)
+}
+
+func (m *MockCategoryRepository) Purge(_ context.Context, id uint) error {
 	args := m.Called(id)
 	errorIndex := 0
 	return args.Error(errorIndex)
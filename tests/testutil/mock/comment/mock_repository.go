@@ -0,0 +1,70 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	commentEntity "github.com/jambo0624/blog/internal/comment/domain/entity"
+	commentQuery "github.com/jambo0624/blog/internal/comment/domain/query"
+)
+
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+const (
+	resultsIndex = 0
+	countIndex   = 1
+	errorIndex   = 2
+)
+
+func (m *MockCommentRepository) Save(_ context.Context, comment *commentEntity.Comment) error {
+	args := m.Called(comment)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) FindByID(_ context.Context, id uint, preloads ...string) (*commentEntity.Comment, error) {
+	args := m.Called(id, preloads)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*commentEntity.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindAll(_ context.Context, query *commentQuery.CommentQuery) ([]*commentEntity.Comment, int64, error) {
+	args := m.Called(query)
+	return args.Get(resultsIndex).([]*commentEntity.Comment),
+		args.Get(countIndex).(int64),
+		args.Error(errorIndex)
+}
+
+func (m *MockCommentRepository) Update(_ context.Context, comment *commentEntity.Comment) error {
+	args := m.Called(comment)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) Delete(_ context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) Restore(_ context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) Purge(_ context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) FindApprovedByArticleID(_ context.Context, articleID uint) ([]*commentEntity.Comment, error) {
+	args := m.Called(articleID)
+	return args.Get(0).([]*commentEntity.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) CountApproved(_ context.Context, articleID uint) (int64, error) {
+	args := m.Called(articleID)
+	return args.Get(0).(int64), args.Error(1)
+}
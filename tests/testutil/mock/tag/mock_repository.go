@@ -1,6 +1,8 @@
 package tag
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 
 	tagEntity "github.com/jambo0624/blog/internal/tag/domain/entity"
@@ -17,12 +19,12 @@ const (
 	errorIndex   = 2
 )
 
-func (m *MockTagRepository) Save(tag *tagEntity.Tag) error {
+func (m *MockTagRepository) Save(_ context.Context, tag *tagEntity.Tag) error {
 	args := m.Called(tag)
 	return args.Error(0)
 }
 
-func (m *MockTagRepository) FindByID(id uint, preloads ...string) (*tagEntity.Tag, error) {
+func (m *MockTagRepository) FindByID(_ context.Context, id uint, preloads ...string) (*tagEntity.Tag, error) {
 	args := m.Called(id, preloads)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -30,19 +32,29 @@ func (m *MockTagRepository) FindByID(id uint, preloads ...string) (*tagEntity.Ta
 	return args.Get(0).(*tagEntity.Tag), args.Error(1)
 }
 
-func (m *MockTagRepository) FindAll(query *tagQuery.TagQuery) ([]*tagEntity.Tag, int64, error) {
+func (m *MockTagRepository) FindAll(_ context.Context, query *tagQuery.TagQuery) ([]*tagEntity.Tag, int64, error) {
 	args := m.Called(query)
 	return args.Get(resultsIndex).([]*tagEntity.Tag),
 		args.Get(countIndex).(int64),
 		args.Error(errorIndex)
 }
 
-func (m *MockTagRepository) Update(tag *tagEntity.Tag) error {
+func (m *MockTagRepository) Update(_ context.Context, tag *tagEntity.Tag) error {
 	args := m.Called(tag)
 	return args.Error(0)
 }
 
-func (m *MockTagRepository) Delete(id uint) error {
+func (m *MockTagRepository) Delete(_ context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) Restore(_ context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) Purge(_ context.Context, id uint) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
@@ -0,0 +1,39 @@
+package user
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	userEntity "github.com/jambo0624/blog/internal/user/domain/entity"
+)
+
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) Save(_ context.Context, key *userEntity.APIKey) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) Update(_ context.Context, key *userEntity.APIKey) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) FindByHash(_ context.Context, hash string) (*userEntity.APIKey, error) {
+	args := m.Called(hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*userEntity.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) FindByUserID(_ context.Context, userID uint) ([]*userEntity.APIKey, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*userEntity.APIKey), args.Error(1)
+}
@@ -0,0 +1,72 @@
+package user
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	userEntity "github.com/jambo0624/blog/internal/user/domain/entity"
+	userQuery "github.com/jambo0624/blog/internal/user/domain/query"
+)
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) Save(_ context.Context, user *userEntity.User) error {
+	args := m.Called(user)
+	errorIndex := 0
+	return args.Error(errorIndex)
+}
+
+func (m *MockUserRepository) FindByID(_ context.Context, id uint, preloads ...string) (*userEntity.User, error) {
+	args := m.Called(id, preloads)
+	resultsIndex := 0
+	errorIndex := 1
+	if args.Get(resultsIndex) == nil {
+		return nil, args.Error(errorIndex)
+	}
+	return args.Get(resultsIndex).(*userEntity.User), args.Error(errorIndex)
+}
+
+func (m *MockUserRepository) FindByEmail(_ context.Context, email string) (*userEntity.User, error) {
+	args := m.Called(email)
+	resultsIndex := 0
+	errorIndex := 1
+	if args.Get(resultsIndex) == nil {
+		return nil, args.Error(errorIndex)
+	}
+	return args.Get(resultsIndex).(*userEntity.User), args.Error(errorIndex)
+}
+
+func (m *MockUserRepository) FindAll(_ context.Context, query *userQuery.UserQuery) ([]*userEntity.User, int64, error) {
+	args := m.Called(query)
+	resultsIndex := 0
+	countIndex := 1
+	errorIndex := 2
+	return args.Get(resultsIndex).([]*userEntity.User), args.Get(countIndex).(int64), args.Error(errorIndex)
+}
+
+func (m *MockUserRepository) Update(_ context.Context, user *userEntity.User) error {
+	args := m.Called(user)
+	errorIndex := 0
+	return args.Error(errorIndex)
+}
+
+func (m *MockUserRepository) Delete(_ context.Context, id uint) error {
+	args := m.Called(id)
+	errorIndex := 0
+	return args.Error(errorIndex)
+}
+
+func (m *MockUserRepository) Restore(_ context.Context, id uint) error {
+	args := m.Called(id)
+	errorIndex := 0
+	return args.Error(errorIndex)
+}
+
+func (m *MockUserRepository) Purge(_ context.Context, id uint) error {
+	args := m.Called(id)
+	errorIndex := 0
+	return args.Error(errorIndex)
+}
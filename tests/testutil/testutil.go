@@ -92,10 +92,16 @@ func SetupTestDB(t *testing.T) (*TestDB, func()) {
 // cleanDB cleans the database.
 func cleanDB(db *gorm.DB) {
 	tables := []string{
+		"comments",
 		"article_tags",
+		"article_revisions",
+		"article_slug_history",
+		"scheduled_publish_runs",
 		"articles",
 		"categories",
 		"tags",
+		"api_keys",
+		"users",
 	}
 	for _, table := range tables {
 		db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", table))
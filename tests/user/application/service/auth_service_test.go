@@ -0,0 +1,153 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/user/application/service"
+	"github.com/jambo0624/blog/internal/user/domain/entity"
+	"github.com/jambo0624/blog/internal/user/infrastructure/token"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+	"github.com/jambo0624/blog/tests/testutil"
+	factory "github.com/jambo0624/blog/tests/testutil/factory"
+	mockUser "github.com/jambo0624/blog/tests/testutil/mock/user"
+)
+
+func setupAuthTest(t *testing.T) (
+	*mockUser.MockUserRepository,
+	*mockUser.MockAPIKeyRepository,
+	*service.AuthService,
+	*testutil.FakeClock,
+) {
+	t.Helper()
+
+	userRepo := new(mockUser.MockUserRepository)
+	keyRepo := new(mockUser.MockAPIKeyRepository)
+	clock := testutil.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	authService := service.NewAuthService(userRepo, keyRepo, token.NewJWTIssuer("secret", time.Hour)).
+		WithClock(clock)
+
+	return userRepo, keyRepo, authService, clock
+}
+
+func TestAuthService_Login(t *testing.T) {
+	userRepo, _, authService, clock := setupAuthTest(t)
+	user := factory.NewUserFactory().BuildEntity()
+
+	userRepo.On("FindByEmail", user.Email).Return(user, nil)
+	userRepo.On("FindByID", user.ID, mock.Anything).Return(user, nil)
+
+	result, err := authService.Login(context.Background(), &dto.LoginRequest{Email: user.Email, Password: factory.TestPassword})
+	require.NoError(t, err)
+	assert.Equal(t, user, result.User)
+	assert.Equal(t, clock.Now().Add(time.Hour), result.ExpiresAt)
+
+	principal, err := authService.AuthenticateToken(context.Background(), result.Token)
+	require.NoError(t, err)
+	assert.Equal(t, &auth.Principal{UserID: user.ID, Role: user.Role, Method: auth.MethodJWT}, principal)
+}
+
+func TestAuthService_Login_InvalidCredentials(t *testing.T) {
+	userRepo, _, authService, _ := setupAuthTest(t)
+	user := factory.NewUserFactory().BuildEntity()
+
+	userRepo.On("FindByEmail", user.Email).Return(user, nil)
+	userRepo.On("FindByEmail", "missing@example.com").Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := authService.Login(context.Background(), &dto.LoginRequest{Email: user.Email, Password: "wrong-password"})
+	require.ErrorIs(t, err, errors.ErrInvalidCredentials)
+
+	_, err = authService.Login(context.Background(), &dto.LoginRequest{Email: "missing@example.com", Password: factory.TestPassword})
+	require.ErrorIs(t, err, errors.ErrInvalidCredentials)
+}
+
+func TestAuthService_AuthenticateToken_Expired(t *testing.T) {
+	userRepo, _, authService, clock := setupAuthTest(t)
+	user := factory.NewUserFactory().BuildEntity()
+
+	userRepo.On("FindByEmail", user.Email).Return(user, nil)
+
+	result, err := authService.Login(context.Background(), &dto.LoginRequest{Email: user.Email, Password: factory.TestPassword})
+	require.NoError(t, err)
+
+	clock.Advance(2 * time.Hour)
+
+	_, err = authService.AuthenticateToken(context.Background(), result.Token)
+	require.ErrorIs(t, err, errors.ErrInvalidToken)
+}
+
+func TestAuthService_AuthenticateToken_DeletedUser(t *testing.T) {
+	userRepo, _, authService, _ := setupAuthTest(t)
+	user := factory.NewUserFactory().BuildEntity()
+
+	userRepo.On("FindByEmail", user.Email).Return(user, nil)
+	result, err := authService.Login(context.Background(), &dto.LoginRequest{Email: user.Email, Password: factory.TestPassword})
+	require.NoError(t, err)
+
+	// Soft-deleted users are no longer found once the token is presented.
+	userRepo.On("FindByID", user.ID, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err = authService.AuthenticateToken(context.Background(), result.Token)
+	require.ErrorIs(t, err, errors.ErrInvalidToken)
+}
+
+func TestAuthService_APIKey(t *testing.T) {
+	userRepo, keyRepo, authService, clock := setupAuthTest(t)
+	user := factory.NewUserFactory().BuildEntity()
+
+	keyRepo.On("Save", mock.AnythingOfType("*entity.APIKey")).Return(nil)
+
+	created, err := authService.CreateAPIKey(context.Background(), user.ID, &dto.CreateAPIKeyRequest{Name: "ci"})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Key)
+	assert.Equal(t, entity.HashAPIKey(created.Key), created.KeyHash)
+
+	keyRepo.On("FindByHash", created.KeyHash).Return(created.APIKey, nil)
+	keyRepo.On("Update", created.APIKey).Return(nil)
+	userRepo.On("FindByID", user.ID, mock.Anything).Return(user, nil)
+
+	principal, err := authService.AuthenticateAPIKey(context.Background(), created.Key)
+	require.NoError(t, err)
+	assert.Equal(t, &auth.Principal{UserID: user.ID, Role: user.Role, Method: auth.MethodAPIKey}, principal)
+	require.NotNil(t, created.LastUsedAt)
+	assert.Equal(t, clock.Now(), *created.LastUsedAt)
+}
+
+func TestAuthService_AuthenticateAPIKey_Unknown(t *testing.T) {
+	_, keyRepo, authService, _ := setupAuthTest(t)
+
+	keyRepo.On("FindByHash", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := authService.AuthenticateAPIKey(context.Background(), "blog_unknown")
+	require.ErrorIs(t, err, errors.ErrInvalidToken)
+}
+
+func TestAuthService_RevokeAPIKey(t *testing.T) {
+	_, keyRepo, authService, clock := setupAuthTest(t)
+
+	key, plaintext, err := entity.NewAPIKey(1, "ci", nil, clock.Now())
+	require.NoError(t, err)
+	key.ID = 5
+
+	keyRepo.On("FindByUserID", uint(1)).Return([]*entity.APIKey{key}, nil)
+	keyRepo.On("Update", key).Return(nil)
+	keyRepo.On("FindByHash", key.KeyHash).Return(key, nil)
+
+	revoked, err := authService.RevokeAPIKey(context.Background(), 1, 5)
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+
+	_, err = authService.AuthenticateAPIKey(context.Background(), plaintext)
+	require.ErrorIs(t, err, errors.ErrAPIKeyRevoked)
+
+	_, err = authService.RevokeAPIKey(context.Background(), 1, 6)
+	require.ErrorIs(t, err, errors.ErrAPIKeyNotFound)
+}
@@ -0,0 +1,84 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/jambo0624/blog/internal/user/application/service"
+	factory "github.com/jambo0624/blog/tests/testutil/factory"
+	mockUser "github.com/jambo0624/blog/tests/testutil/mock/user"
+)
+
+func setupUserTest(t *testing.T) (
+	*mockUser.MockUserRepository,
+	*service.UserService,
+	*factory.UserFactory,
+) {
+	t.Helper()
+
+	mockRepo := new(mockUser.MockUserRepository)
+	userService := service.NewUserService(mockRepo)
+	factory := factory.NewUserFactory()
+
+	return mockRepo, userService, factory
+}
+
+func TestUserService_Create(t *testing.T) {
+	mockRepo, userService, factory := setupUserTest(t)
+
+	req := factory.BuildCreateRequest()
+
+	mockRepo.On("Save", mock.AnythingOfType("*entity.User")).Return(nil)
+
+	user, err := userService.Create(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, req.Email, user.Email)
+	assert.NotEqual(t, req.Password, user.PasswordHash)
+	assert.True(t, user.CheckPassword(req.Password))
+}
+
+func TestUserService_Create_ValidationError(t *testing.T) {
+	mockRepo, userService, factory := setupUserTest(t)
+
+	req := factory.BuildCreateRequest()
+	req.Password = "short"
+
+	user, err := userService.Create(context.Background(), req)
+
+	require.Error(t, err)
+	assert.Nil(t, user)
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+func TestUserService_Update(t *testing.T) {
+	mockRepo, userService, factory := setupUserTest(t)
+
+	user := factory.BuildEntity()
+
+	mockRepo.On("FindByID", user.ID, mock.Anything).Return(user, nil)
+	mockRepo.On("Update", mock.AnythingOfType("*entity.User")).Return(nil)
+
+	req := factory.BuildUpdateRequest()
+	updated, err := userService.Update(context.Background(), user.ID, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, req.Name, updated.Name)
+}
+
+func TestUserService_Update_NotFound(t *testing.T) {
+	mockRepo, userService, factory := setupUserTest(t)
+
+	mockRepo.On("FindByID", uint(999), mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+
+	user, err := userService.Update(context.Background(), 999, factory.BuildUpdateRequest())
+
+	require.Error(t, err)
+	assert.Nil(t, user)
+	mockRepo.AssertNotCalled(t, "Update")
+}
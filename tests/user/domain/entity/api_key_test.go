@@ -0,0 +1,51 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/user/domain/entity"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	key, plaintext, err := entity.NewAPIKey(1, "ci", nil, now)
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(plaintext, "blog_"))
+	assert.True(t, strings.HasPrefix(plaintext, key.Prefix))
+	assert.Equal(t, entity.HashAPIKey(plaintext), key.KeyHash)
+	assert.NotContains(t, key.KeyHash, plaintext)
+	assert.Equal(t, uint(1), key.UserID)
+	assert.Equal(t, now, key.CreatedAt)
+
+	_, other, err := entity.NewAPIKey(1, "ci", nil, now)
+	require.NoError(t, err)
+	assert.NotEqual(t, plaintext, other)
+}
+
+func TestNewAPIKey_NameRequired(t *testing.T) {
+	_, _, err := entity.NewAPIKey(1, "", nil, time.Now())
+
+	require.ErrorIs(t, err, errors.ErrNameRequired)
+}
+
+func TestAPIKey_Verify(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour)
+
+	key, _, err := entity.NewAPIKey(1, "ci", &expiresAt, now)
+	require.NoError(t, err)
+
+	require.NoError(t, key.Verify(now))
+	require.ErrorIs(t, key.Verify(expiresAt), errors.ErrAPIKeyExpired)
+
+	key.Revoke(now)
+	require.ErrorIs(t, key.Verify(now), errors.ErrAPIKeyRevoked)
+}
@@ -0,0 +1,120 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/user/domain/entity"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+)
+
+func TestNewUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		email       string
+		userName    string
+		password    string
+		expectedErr error
+	}{
+		{
+			name:     "valid user",
+			email:    " Jane@Example.com ",
+			userName: "Jane",
+			password: "s3cret-password",
+		},
+		{
+			name:        "empty email",
+			userName:    "Jane",
+			password:    "s3cret-password",
+			expectedErr: errors.ErrEmailRequired,
+		},
+		{
+			name:        "empty name",
+			email:       "jane@example.com",
+			password:    "s3cret-password",
+			expectedErr: errors.ErrNameRequired,
+		},
+		{
+			name:        "empty password",
+			email:       "jane@example.com",
+			userName:    "Jane",
+			expectedErr: errors.ErrPasswordRequired,
+		},
+		{
+			name:        "short password",
+			email:       "jane@example.com",
+			userName:    "Jane",
+			password:    "short",
+			expectedErr: errors.ErrPasswordTooShort,
+		},
+		{
+			name:        "long password",
+			email:       "jane@example.com",
+			userName:    "Jane",
+			password:    strings.Repeat("a", 73),
+			expectedErr: errors.ErrPasswordTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := entity.NewUser(tt.email, tt.userName, tt.password)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, user)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "jane@example.com", user.Email)
+			assert.NotEqual(t, tt.password, user.PasswordHash)
+			assert.True(t, user.CheckPassword(tt.password))
+			assert.False(t, user.CheckPassword("wrong-password"))
+		})
+	}
+}
+
+func TestUser_Update(t *testing.T) {
+	user, err := entity.NewUser("jane@example.com", "Jane", "s3cret-password")
+	require.NoError(t, err)
+
+	err = user.Update(&dto.UpdateUserRequest{Name: "Janet", Password: "new-password"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Janet", user.Name)
+	assert.True(t, user.CheckPassword("new-password"))
+	assert.False(t, user.CheckPassword("s3cret-password"))
+}
+
+func TestUser_SetRole(t *testing.T) {
+	user, err := entity.NewUser("jane@example.com", "Jane", "s3cret-password")
+	require.NoError(t, err)
+	assert.Equal(t, auth.RoleReader, user.Role)
+
+	require.NoError(t, user.SetRole(auth.RoleEditor))
+	assert.Equal(t, auth.RoleEditor, user.Role)
+
+	assert.ErrorIs(t, user.SetRole(auth.Role("owner")), errors.ErrInvalidRole)
+	assert.Equal(t, auth.RoleEditor, user.Role)
+}
+
+func TestUser_Update_Profile(t *testing.T) {
+	user, err := entity.NewUser("jane@example.com", "Jane", "s3cret-password")
+	require.NoError(t, err)
+
+	bio := "Writes about Go."
+	avatar := "https://example.com/jane.png"
+	require.NoError(t, user.Update(&dto.UpdateUserRequest{Bio: &bio, AvatarURL: &avatar}))
+	assert.Equal(t, bio, user.Bio)
+	assert.Equal(t, avatar, user.AvatarURL)
+
+	empty := ""
+	require.NoError(t, user.Update(&dto.UpdateUserRequest{Bio: &empty}))
+	assert.Empty(t, user.Bio)
+	assert.Equal(t, avatar, user.AvatarURL)
+}
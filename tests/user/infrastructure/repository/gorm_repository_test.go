@@ -0,0 +1,95 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userEntity "github.com/jambo0624/blog/internal/user/domain/entity"
+	userQuery "github.com/jambo0624/blog/internal/user/domain/query"
+	userRepository "github.com/jambo0624/blog/internal/user/domain/repository"
+	userPersistence "github.com/jambo0624/blog/internal/user/infrastructure/repository"
+	"github.com/jambo0624/blog/tests/testutil"
+	factory "github.com/jambo0624/blog/tests/testutil/factory"
+)
+
+func setupTest(t *testing.T) (
+	func(),
+	userRepository.UserRepository,
+	userRepository.APIKeyRepository,
+	*factory.UserFactory,
+) {
+	t.Helper()
+
+	testDB, cleanup := testutil.SetupTestDB(t)
+	repo := userPersistence.NewGormUserRepository(testDB.DB)
+	keyRepo := userPersistence.NewGormAPIKeyRepository(testDB.DB)
+	userFactory := factory.NewUserFactory()
+
+	return cleanup, repo, keyRepo, userFactory
+}
+
+func saveUser(t *testing.T, repo userRepository.UserRepository, userFactory *factory.UserFactory) *userEntity.User {
+	t.Helper()
+
+	user := userFactory.BuildEntity()
+	user.ID = 0
+	require.NoError(t, repo.Save(context.Background(), user))
+	return user
+}
+
+func TestGormUserRepository_FindByEmail(t *testing.T) {
+	cleanup, repo, _, userFactory := setupTest(t)
+	defer cleanup()
+
+	user := saveUser(t, repo, userFactory)
+
+	found, err := repo.FindByEmail(context.Background(), user.Email)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, found.ID)
+	assert.True(t, found.CheckPassword(factory.TestPassword))
+
+	require.NoError(t, repo.Delete(context.Background(), user.ID))
+	_, err = repo.FindByEmail(context.Background(), user.Email)
+	require.Error(t, err)
+}
+
+func TestGormUserRepository_FindAll(t *testing.T) {
+	cleanup, repo, _, userFactory := setupTest(t)
+	defer cleanup()
+
+	user := saveUser(t, repo, userFactory)
+
+	q := userQuery.NewUserQuery()
+	q.WithEmailLike(user.Email)
+
+	users, total, err := repo.FindAll(context.Background(), q)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, user.Email, users[0].Email)
+}
+
+func TestGormAPIKeyRepository(t *testing.T) {
+	cleanup, repo, keyRepo, userFactory := setupTest(t)
+	defer cleanup()
+
+	user := saveUser(t, repo, userFactory)
+	key, plaintext, err := userEntity.NewAPIKey(user.ID, "ci", nil, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, keyRepo.Save(context.Background(), key))
+
+	found, err := keyRepo.FindByHash(context.Background(), userEntity.HashAPIKey(plaintext))
+	require.NoError(t, err)
+	assert.Equal(t, key.ID, found.ID)
+
+	found.Revoke(time.Now())
+	require.NoError(t, keyRepo.Update(context.Background(), found))
+
+	keys, err := keyRepo.FindByUserID(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.NotNil(t, keys[0].RevokedAt)
+}
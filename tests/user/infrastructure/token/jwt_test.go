@@ -0,0 +1,48 @@
+package token_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jambo0624/blog/internal/shared/domain/errors"
+	"github.com/jambo0624/blog/internal/user/infrastructure/token"
+)
+
+func TestJWTIssuer_IssueAndParse(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	issuer := token.NewJWTIssuer("secret", time.Hour)
+
+	signed, expiresAt, err := issuer.Issue(42, now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Hour), expiresAt)
+
+	userID, err := issuer.Parse(signed, now.Add(30*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, uint(42), userID)
+}
+
+func TestJWTIssuer_Parse_Invalid(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	issuer := token.NewJWTIssuer("secret", time.Hour)
+
+	signed, _, err := issuer.Issue(42, now)
+	require.NoError(t, err)
+
+	t.Run("expired", func(t *testing.T) {
+		_, err := issuer.Parse(signed, now.Add(2*time.Hour))
+		require.ErrorIs(t, err, errors.ErrInvalidToken)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		_, err := token.NewJWTIssuer("other", time.Hour).Parse(signed, now)
+		require.ErrorIs(t, err, errors.ErrInvalidToken)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := issuer.Parse("not-a-token", now)
+		require.ErrorIs(t, err, errors.ErrInvalidToken)
+	})
+}
@@ -0,0 +1,97 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	userService "github.com/jambo0624/blog/internal/user/application/service"
+	"github.com/jambo0624/blog/internal/user/domain/entity"
+	"github.com/jambo0624/blog/internal/user/infrastructure/token"
+	userHandler "github.com/jambo0624/blog/internal/user/interfaces/http"
+	"github.com/jambo0624/blog/internal/user/interfaces/http/dto"
+	"github.com/jambo0624/blog/tests/testutil"
+	"github.com/jambo0624/blog/tests/testutil/factory"
+	mockUser "github.com/jambo0624/blog/tests/testutil/mock/user"
+)
+
+func setupAuthTest(t *testing.T) (
+	*testutil.HTTPTester,
+	*mockUser.MockUserRepository,
+	*mockUser.MockAPIKeyRepository,
+) {
+	t.Helper()
+
+	userRepo := new(mockUser.MockUserRepository)
+	keyRepo := new(mockUser.MockAPIKeyRepository)
+	service := userService.NewAuthService(userRepo, keyRepo, token.NewJWTIssuer("secret", time.Hour))
+	handler := userHandler.NewAuthHandler(service)
+	router := userHandler.NewAuthRouter(handler, service)
+
+	tester := testutil.NewHTTPTester(t, router.Register)
+
+	return tester, userRepo, keyRepo
+}
+
+func TestAuthHandler_Login(t *testing.T) {
+	tester, userRepo, _ := setupAuthTest(t)
+	user := factory.NewUserFactory().BuildEntity()
+
+	userRepo.On("FindByEmail", user.Email).Return(user, nil)
+
+	tester.
+		WithJSONBody(dto.LoginRequest{Email: user.Email, Password: factory.TestPassword}).
+		Post("/api/auth/login").
+		SeeStatus(http.StatusOK)
+
+	tester.
+		WithJSONBody(dto.LoginRequest{Email: user.Email, Password: "wrong-password"}).
+		Post("/api/auth/login").
+		SeeStatus(http.StatusUnauthorized)
+}
+
+func TestAuthHandler_Login_UnknownEmail(t *testing.T) {
+	tester, userRepo, _ := setupAuthTest(t)
+
+	userRepo.On("FindByEmail", "nobody@example.com").Return(nil, gorm.ErrRecordNotFound)
+
+	tester.
+		WithJSONBody(dto.LoginRequest{Email: "nobody@example.com", Password: factory.TestPassword}).
+		Post("/api/auth/login").
+		SeeStatus(http.StatusUnauthorized)
+}
+
+func TestAuthHandler_Me_RequiresAuth(t *testing.T) {
+	tester, _, _ := setupAuthTest(t)
+
+	tester.
+		Get("/api/auth/me", nil).
+		SeeStatus(http.StatusUnauthorized)
+}
+
+func TestAuthHandler_CreateAPIKey(t *testing.T) {
+	tester, userRepo, keyRepo := setupAuthTest(t)
+	user := factory.NewUserFactory().BuildEntity()
+
+	userRepo.On("FindByEmail", user.Email).Return(user, nil)
+	userRepo.On("FindByID", user.ID, mock.Anything).Return(user, nil)
+	keyRepo.On("Save", mock.MatchedBy(func(k *entity.APIKey) bool {
+		return k.UserID == user.ID && k.Name == "ci"
+	})).Return(nil)
+
+	issuer := token.NewJWTIssuer("secret", time.Hour)
+	signed, _, err := issuer.Issue(user.ID, time.Now())
+	require.NoError(t, err)
+
+	tester.
+		WithHeader("Authorization", "Bearer "+signed).
+		WithJSONBody(dto.CreateAPIKeyRequest{Name: "ci"}).
+		Post("/api/auth/api-keys").
+		SeeStatus(http.StatusCreated)
+
+	keyRepo.AssertExpectations(t)
+}
@@ -0,0 +1,96 @@
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jambo0624/blog/internal/shared/domain/auth"
+	userService "github.com/jambo0624/blog/internal/user/application/service"
+	"github.com/jambo0624/blog/internal/user/domain/entity"
+	userHandler "github.com/jambo0624/blog/internal/user/interfaces/http"
+	"github.com/jambo0624/blog/tests/testutil"
+	"github.com/jambo0624/blog/tests/testutil/factory"
+	mockUser "github.com/jambo0624/blog/tests/testutil/mock/user"
+)
+
+func setupTest(t *testing.T) (*testutil.HTTPTester, *mockUser.MockUserRepository) {
+	t.Helper()
+
+	mockRepo := new(mockUser.MockUserRepository)
+	service := userService.NewUserService(mockRepo)
+	handler := userHandler.NewUserHandler(service)
+	router := userHandler.NewUserRouter(handler, testutil.NewFakeAuthenticator(1, auth.RoleAdmin))
+
+	tester := testutil.NewHTTPTester(t, router.Register)
+	tester.WithHeader("Authorization", "Bearer "+testutil.TestToken)
+
+	return tester, mockRepo
+}
+
+func TestUserHandler_Create(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewUserFactory()
+
+	req := factory.BuildCreateRequest()
+
+	mockRepo.On("Save", mock.MatchedBy(func(u *entity.User) bool {
+		return u.Email == req.Email && u.CheckPassword(req.Password)
+	})).Return(nil)
+
+	tester.
+		WithJSONBody(req).
+		Post("/api/users").
+		SeeStatus(http.StatusCreated)
+}
+
+func TestUserHandler_Create_InvalidEmail(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	factory := factory.NewUserFactory()
+
+	req := factory.BuildCreateRequest()
+	req.Email = "not-an-email"
+
+	tester.
+		WithJSONBody(req).
+		Post("/api/users").
+		SeeStatus(http.StatusUnprocessableEntity)
+
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestUserHandler_GetByID(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	user := factory.NewUserFactory().BuildEntity()
+
+	mockRepo.On("FindByID", user.ID, mock.Anything).Return(user, nil)
+
+	tester.
+		Get(fmt.Sprintf("/api/users/%d", user.ID), nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestUserHandler_List(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+	users := factory.NewUserFactory().BuildList(2)
+
+	mockRepo.On("FindAll", mock.AnythingOfType("*query.UserQuery")).
+		Return(users, int64(len(users)), nil)
+
+	tester.
+		Get("/api/users", nil).
+		SeeStatus(http.StatusOK)
+}
+
+func TestUserHandler_RequiresAuth(t *testing.T) {
+	tester, mockRepo := setupTest(t)
+
+	tester.
+		WithHeader("Authorization", "").
+		Get("/api/users", nil).
+		SeeStatus(http.StatusUnauthorized)
+
+	mockRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+}